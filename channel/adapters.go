@@ -0,0 +1,83 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/list/arraylist"
+)
+
+/*
+Receives values from the given channel until it is closed or the given context is done, and returns them as
+a new instance of List with a default equality comparer, in the order they were received.
+Elements must be comparable
+*/
+func FromChannel[K comparable](ctx context.Context, ch <-chan K) arraylist.List[K] {
+	l := arraylist.NewOfAny[K]()
+	l.SetEqualityComparer(comparer.DefaultEquals[K])
+	AddFromChannel[K](ctx, ch, &l)
+
+	return l
+}
+
+/*
+Receives values from the given channel until it is closed or the given context is done, and returns them as
+a new instance of List with nil equality comparer, in the order they were received.
+Elements can be of any type
+*/
+func FromChannelOfAny[T any](ctx context.Context, ch <-chan T) arraylist.List[T] {
+	l := arraylist.NewOfAny[T]()
+	AddFromChannel[T](ctx, ch, &l)
+
+	return l
+}
+
+/*
+Receives values from the given channel until it is closed or the given context is done, and adds each of
+them to the given collection. Returns the number of values that the collection reported as added
+*/
+func AddFromChannel[T any](ctx context.Context, ch <-chan T, c generic.Collectioner[T]) int {
+	added := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return added
+		case element, ok := <-ch:
+			if !ok {
+				return added
+			}
+
+			if c.Add(element) {
+				added++
+			}
+		}
+	}
+}
+
+/*
+Returns a channel that receives every element of the given collection in its iteration order, and is closed
+once all elements are sent or the given context is done. The elements are copied from the collection before
+this function returns, so the collection can be modified freely while the channel is being drained
+*/
+func ToChannel[T any](ctx context.Context, c generic.Collectioner[T]) <-chan T {
+	elements := make([]T, 0, c.Size())
+	c.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+
+		for _, element := range elements {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- element:
+			}
+		}
+	}()
+
+	return ch
+}
@@ -0,0 +1,112 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/list/arraylist"
+	"github.com/golanglibs/gocollections/set/hashset"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func sendAndClose[T any](elements ...T) <-chan T {
+	ch := make(chan T, len(elements))
+	for _, element := range elements {
+		ch <- element
+	}
+	close(ch)
+
+	return ch
+}
+
+func collect[T any](ch <-chan T) []T {
+	var elements []T
+	for element := range ch {
+		elements = append(elements, element)
+	}
+
+	return elements
+}
+
+func Test_FromChannelShouldCreateList_WithReceivedElementsInOrder_And_DefaultEquals(t *testing.T) {
+	list := FromChannel(context.Background(), sendAndClose(10, 16, 14))
+
+	goassert.Equal(t, 3, list.Size())
+	goassert.Equal(t, 10, *list.At(0))
+	goassert.Equal(t, 16, *list.At(1))
+	goassert.Equal(t, 14, *list.At(2))
+	goassert.True(t, list.Contains(16))
+}
+
+func Test_FromChannelShouldCreateEmptyList_GivenClosedEmptyChannel(t *testing.T) {
+	list := FromChannel(context.Background(), sendAndClose[int]())
+
+	goassert.True(t, list.Empty())
+}
+
+func Test_FromChannelShouldStop_WhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	list := FromChannel(ctx, make(chan int))
+
+	goassert.True(t, list.Empty())
+}
+
+func Test_FromChannelOfAnyShouldCreateList_WithReceivedElements_And_NilEquals(t *testing.T) {
+	ch := sendAndClose(testhelpers.MockStruct{Prop: 10}, testhelpers.MockStruct{Prop: 16})
+
+	list := FromChannelOfAny(context.Background(), ch)
+
+	goassert.Equal(t, 2, list.Size())
+	goassert.Equal(t, 10, list.At(0).Prop)
+	goassert.Equal(t, 16, list.At(1).Prop)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot compute equality of elements since equality comparer is not set",
+		func() { list.Contains(testhelpers.MockStruct{Prop: 10}) },
+	)
+}
+
+func Test_AddFromChannelShouldAddElementsToCollection_AndReturnNumberOfAddedElements(t *testing.T) {
+	set := hashset.New(10)
+
+	added := AddFromChannel[int](context.Background(), sendAndClose(10, 16, 14, 16), &set)
+
+	goassert.Equal(t, 2, added)
+	goassert.Equal(t, 3, set.Size())
+	goassert.True(t, set.Contains(14))
+}
+
+func Test_ToChannelShouldSendEachElementInIterationOrder_AndClose(t *testing.T) {
+	list := arraylist.New(10, 16, 14)
+
+	elements := collect(ToChannel[int](context.Background(), &list))
+
+	goassert.DeepEqual(t, []int{10, 16, 14}, elements)
+}
+
+func Test_ToChannelShouldSendSnapshotOfCollection(t *testing.T) {
+	list := arraylist.New(10, 16)
+
+	ch := ToChannel[int](context.Background(), &list)
+	list.Set(0, 5)
+	list.Add(14)
+
+	goassert.DeepEqual(t, []int{10, 16}, collect(ch))
+}
+
+func Test_ToChannelShouldClose_WhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	list := arraylist.New(10, 16, 14)
+
+	ch := ToChannel[int](ctx, &list)
+	goassert.Equal(t, 10, <-ch)
+	cancel()
+
+	for range ch {
+	}
+}
@@ -0,0 +1,91 @@
+package channel
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/golanglibs/gocollections/queue"
+	"github.com/golanglibs/gocollections/queue/linkedlistqueue"
+)
+
+/*
+Channel with an unbounded buffer. Values sent to In are buffered in a gocollections/queue/linkedlistqueue and
+delivered to Out in FIFO order, so producers never block on slow consumers.
+Closing In flushes the buffered values to Out and then closes Out. When the context given at construction is
+done, the buffered values are discarded and Out is closed immediately.
+Unbounded is safe for concurrent use
+*/
+type Unbounded[T any] struct {
+	in     chan T
+	out    chan T
+	buffer queue.Queuer[T]
+	size   atomic.Int64
+}
+
+/*
+Creates a new instance of Unbounded and starts the goroutine moving values from In to Out. The goroutine
+exits when In is closed and flushed, or when the given context is done
+*/
+func NewUnbounded[T any](ctx context.Context) *Unbounded[T] {
+	buffer := linkedlistqueue.NewOfAny[T]()
+	u := &Unbounded[T]{
+		in:     make(chan T),
+		out:    make(chan T),
+		buffer: &buffer,
+	}
+
+	go u.run(ctx)
+
+	return u
+}
+
+/*
+Returns the sending end of the Unbounded channel. Sends only block until the value is buffered
+*/
+func (u *Unbounded[T]) In() chan<- T {
+	return u.in
+}
+
+/*
+Returns the receiving end of the Unbounded channel
+*/
+func (u *Unbounded[T]) Out() <-chan T {
+	return u.out
+}
+
+/*
+Returns the number of values that were sent to In but not yet received from Out
+*/
+func (u *Unbounded[T]) Len() int {
+	return int(u.size.Load())
+}
+
+func (u *Unbounded[T]) run(ctx context.Context) {
+	defer close(u.out)
+
+	in := u.in
+	for in != nil || !u.buffer.Empty() {
+		var out chan T
+		var next T
+		if !u.buffer.Empty() {
+			out = u.out
+			next = *u.buffer.Peek()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case element, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+
+			u.buffer.Enqueue(element)
+			u.size.Add(1)
+		case out <- next:
+			u.buffer.Dequeue()
+			u.size.Add(-1)
+		}
+	}
+}
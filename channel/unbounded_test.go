@@ -0,0 +1,78 @@
+package channel
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_UnboundedShouldNotBlockProducers_WhenNoOneIsReceiving(t *testing.T) {
+	u := NewUnbounded[int](context.Background())
+
+	for i := 0; i < 1000; i++ {
+		u.In() <- i
+	}
+
+	for u.Len() != 1000 {
+		runtime.Gosched()
+	}
+	goassert.Equal(t, 1000, u.Len())
+}
+
+func Test_UnboundedShouldDeliverValuesInFifoOrder(t *testing.T) {
+	u := NewUnbounded[int](context.Background())
+
+	for i := 0; i < 100; i++ {
+		u.In() <- i
+	}
+	close(u.In())
+
+	received := collect(u.Out())
+
+	goassert.SliceLength(t, received, 100)
+	for i, v := range received {
+		goassert.Equal(t, i, v)
+	}
+	goassert.Equal(t, 0, u.Len())
+}
+
+func Test_UnboundedShouldCloseOut_WhenInIsClosedAndEmpty(t *testing.T) {
+	u := NewUnbounded[int](context.Background())
+	close(u.In())
+
+	_, ok := <-u.Out()
+
+	goassert.False(t, ok)
+}
+
+func Test_UnboundedShouldCloseOutAndDiscardBuffer_WhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	u := NewUnbounded[int](ctx)
+	u.In() <- 10
+	u.In() <- 16
+
+	cancel()
+
+	for range u.Out() {
+	}
+}
+
+func Test_UnboundedShouldInterleaveSendsAndReceives(t *testing.T) {
+	u := NewUnbounded[int](context.Background())
+
+	go func() {
+		for i := 0; i < 10000; i++ {
+			u.In() <- i
+		}
+		close(u.In())
+	}()
+
+	expected := 0
+	for v := range u.Out() {
+		goassert.Equal(t, expected, v)
+		expected++
+	}
+	goassert.Equal(t, 10000, expected)
+}
@@ -0,0 +1,57 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+/*
+Encodes the given elements into a self-describing binary payload made of a header (see WriteHeader) followed
+by the gob encoding of the elements. Element types must be encodable by encoding/gob
+*/
+func MarshalElements[T any](kind Kind, elements []T) ([]byte, error) {
+	var buffer bytes.Buffer
+	if _, err := WriteHeader(&buffer, kind, len(elements)); err != nil {
+		return nil, err
+	}
+
+	if err := gob.NewEncoder(&buffer).Encode(elements); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+/*
+Decodes a payload produced by MarshalElements for the given kind of collection and returns its elements.
+Returns an error instead of panicking if the payload is truncated, corrupted, has trailing bytes, was produced
+by a different kind of collection or holds a different number of elements than stated in its header
+*/
+func UnmarshalElements[T any](kind Kind, data []byte) ([]T, error) {
+	reader := bytes.NewReader(data)
+	count, _, err := ReadHeader(reader, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]T, 0, PreallocationSize(count))
+	if err := gob.NewDecoder(reader).Decode(&elements); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptedPayload, err)
+	}
+
+	if len(elements) != count {
+		return nil, fmt.Errorf(
+			"%w: header states %d elements but payload holds %d",
+			ErrCorruptedPayload,
+			count,
+			len(elements),
+		)
+	}
+
+	if reader.Len() > 0 {
+		return nil, fmt.Errorf("%w: %d unexpected trailing bytes", ErrCorruptedPayload, reader.Len())
+	}
+
+	return elements, nil
+}
@@ -0,0 +1,81 @@
+package codec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_MarshalElementsShouldRoundTripWithUnmarshalElements(t *testing.T) {
+	elements := []testhelpers.MockStruct{{Prop: 10}, {Prop: 16}, {Prop: 14}}
+
+	data, err := MarshalElements(KindArrayList, elements)
+	goassert.Nil(t, err)
+
+	decoded, err := UnmarshalElements[testhelpers.MockStruct](KindArrayList, data)
+
+	goassert.Nil(t, err)
+	goassert.DeepEqual(t, elements, decoded)
+}
+
+func Test_MarshalElementsShouldRoundTrip_GivenNoElements(t *testing.T) {
+	data, err := MarshalElements(KindHashSet, []string{})
+	goassert.Nil(t, err)
+
+	decoded, err := UnmarshalElements[string](KindHashSet, data)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 0, len(decoded))
+}
+
+func Test_UnmarshalElementsShouldReturnErrKindMismatch_GivenPayloadOfDifferentKind(t *testing.T) {
+	data, _ := MarshalElements(KindArrayStack, []int{1, 2})
+
+	_, err := UnmarshalElements[int](KindLinkedListStack, data)
+
+	goassert.True(t, errors.Is(err, ErrKindMismatch))
+}
+
+func Test_UnmarshalElementsShouldReturnErrCorruptedPayload_GivenTruncatedPayload(t *testing.T) {
+	data, _ := MarshalElements(KindArrayList, []int{1, 2, 3})
+
+	_, err := UnmarshalElements[int](KindArrayList, data[:len(data)-2])
+
+	goassert.True(t, errors.Is(err, ErrCorruptedPayload))
+}
+
+func Test_UnmarshalElementsShouldReturnErrCorruptedPayload_GivenMismatchedCount(t *testing.T) {
+	data, _ := MarshalElements(KindArrayList, []int{1, 2, 3})
+	data[4] = 7
+
+	_, err := UnmarshalElements[int](KindArrayList, data)
+
+	goassert.True(t, errors.Is(err, ErrCorruptedPayload))
+}
+
+func Test_UnmarshalElementsShouldReturnErrCorruptedPayload_GivenTrailingBytes(t *testing.T) {
+	data, _ := MarshalElements(KindArrayList, []int{1, 2, 3})
+
+	_, err := UnmarshalElements[int](KindArrayList, append(data, 0xff))
+
+	goassert.True(t, errors.Is(err, ErrCorruptedPayload))
+}
+
+func Test_UnmarshalElementsShouldReturnErrCorruptedPayload_GivenMismatchedElementType(t *testing.T) {
+	data, _ := MarshalElements(KindArrayList, []string{"a", "b"})
+
+	_, err := UnmarshalElements[int](KindArrayList, data)
+
+	goassert.True(t, errors.Is(err, ErrCorruptedPayload))
+}
+
+func Test_UnmarshalElementsShouldNotPanic_GivenGarbage(t *testing.T) {
+	garbage := []byte{'G', 'C', Version, byte(KindArrayList), 3, 0xff, 0x00, 0x13, 0x37, 0xde, 0xad}
+
+	goassert.NotPanic(t, func() {
+		_, err := UnmarshalElements[int](KindArrayList, garbage)
+		goassert.True(t, errors.Is(err, ErrCorruptedPayload))
+	})
+}
@@ -0,0 +1,122 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+Identifies the collection that produced an encoded payload, so that a payload cannot be decoded into a
+different kind of collection by mistake
+*/
+type Kind byte
+
+const (
+	KindArrayList Kind = iota + 1
+	KindDoublyLinkedList
+	KindHashSet
+	KindLinkedListQueue
+	KindPriorityQueue
+	KindArrayStack
+	KindLinkedListStack
+)
+
+/* Version of the header layout written by this package */
+const Version byte = 1
+
+var magic = [2]byte{'G', 'C'}
+
+/* Maximum number of elements to preallocate for based on the untrusted count stored in a header */
+const maxPreallocation = 1 << 16
+
+var (
+	ErrInvalidHeader      = errors.New("codec: invalid header")
+	ErrUnsupportedVersion = errors.New("codec: unsupported version")
+	ErrKindMismatch       = errors.New("codec: collection kind mismatch")
+	ErrCorruptedPayload   = errors.New("codec: corrupted payload")
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindArrayList:
+		return "ArrayList"
+	case KindDoublyLinkedList:
+		return "DoublyLinkedList"
+	case KindHashSet:
+		return "HashSet"
+	case KindLinkedListQueue:
+		return "LinkedListQueue"
+	case KindPriorityQueue:
+		return "PriorityQueue"
+	case KindArrayStack:
+		return "ArrayStack"
+	case KindLinkedListStack:
+		return "LinkedListStack"
+	default:
+		return fmt.Sprintf("Kind(%d)", byte(k))
+	}
+}
+
+/*
+Writes the header made of the magic bytes, the format version, the given collection kind and the given
+element count to the given writer. Returns the number of bytes written
+*/
+func WriteHeader(w io.Writer, kind Kind, count int) (int64, error) {
+	header := []byte{magic[0], magic[1], Version, byte(kind)}
+	header = appendUvarint(header, uint64(count))
+
+	n, err := w.Write(header)
+	return int64(n), err
+}
+
+/*
+Reads a header written by WriteHeader from the given reader and returns the element count stored in it.
+Returns an error wrapping ErrInvalidHeader, ErrUnsupportedVersion or ErrKindMismatch if the header is
+malformed, was written by an unknown version or belongs to a different kind of collection than the given
+one. Only the bytes of the header are consumed from the reader. The second return value is the number of
+bytes read
+*/
+func ReadHeader(r io.Reader, kind Kind) (int, int64, error) {
+	reader := newCountingByteReader(r)
+
+	var fixed [4]byte
+	if _, err := io.ReadFull(reader, fixed[:]); err != nil {
+		return 0, reader.count, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+
+	if fixed[0] != magic[0] || fixed[1] != magic[1] {
+		return 0, reader.count, fmt.Errorf("%w: unexpected magic bytes %q", ErrInvalidHeader, fixed[:2])
+	}
+
+	if fixed[2] != Version {
+		return 0, reader.count, fmt.Errorf("%w: %d", ErrUnsupportedVersion, fixed[2])
+	}
+
+	if actual := Kind(fixed[3]); actual != kind {
+		return 0, reader.count, fmt.Errorf("%w: expected %v but found %v", ErrKindMismatch, kind, actual)
+	}
+
+	count, err := readUvarint(reader)
+	if err != nil {
+		return 0, reader.count, fmt.Errorf("%w: invalid element count: %v", ErrInvalidHeader, err)
+	}
+
+	if count > uint64(int(^uint(0)>>1)) {
+		return 0, reader.count, fmt.Errorf("%w: element count %d is too large", ErrInvalidHeader, count)
+	}
+
+	return int(count), reader.count, nil
+}
+
+/*
+Returns the capacity to preallocate for the given untrusted element count, so that a corrupted header cannot
+trigger a huge allocation before the payload is validated
+*/
+func PreallocationSize(count int) int {
+	if count > maxPreallocation {
+		return maxPreallocation
+	}
+
+	return count
+}
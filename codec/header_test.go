@@ -0,0 +1,71 @@
+package codec
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_WriteHeaderShouldWriteMagicVersionKindAndCount(t *testing.T) {
+	var buffer bytes.Buffer
+
+	n, err := WriteHeader(&buffer, KindHashSet, 300)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, int64(6), n)
+	goassert.DeepEqual(t, []byte{'G', 'C', Version, byte(KindHashSet), 0xac, 0x02}, buffer.Bytes())
+}
+
+func Test_ReadHeaderShouldReturnCount_GivenHeaderWrittenByWriteHeader(t *testing.T) {
+	var buffer bytes.Buffer
+	WriteHeader(&buffer, KindArrayList, 300)
+	buffer.WriteString("payload")
+
+	count, n, err := ReadHeader(&buffer, KindArrayList)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 300, count)
+	goassert.Equal(t, int64(6), n)
+	goassert.Equal(t, "payload", buffer.String())
+}
+
+func Test_ReadHeaderShouldReturnErrInvalidHeader_GivenTruncatedHeader(t *testing.T) {
+	_, _, err := ReadHeader(bytes.NewReader([]byte{'G', 'C'}), KindArrayList)
+
+	goassert.True(t, errors.Is(err, ErrInvalidHeader))
+}
+
+func Test_ReadHeaderShouldReturnErrInvalidHeader_GivenWrongMagicBytes(t *testing.T) {
+	_, _, err := ReadHeader(bytes.NewReader([]byte{'X', 'Y', Version, byte(KindArrayList), 0}), KindArrayList)
+
+	goassert.True(t, errors.Is(err, ErrInvalidHeader))
+}
+
+func Test_ReadHeaderShouldReturnErrInvalidHeader_GivenMissingCount(t *testing.T) {
+	_, _, err := ReadHeader(bytes.NewReader([]byte{'G', 'C', Version, byte(KindArrayList)}), KindArrayList)
+
+	goassert.True(t, errors.Is(err, ErrInvalidHeader))
+}
+
+func Test_ReadHeaderShouldReturnErrUnsupportedVersion_GivenUnknownVersion(t *testing.T) {
+	_, _, err := ReadHeader(bytes.NewReader([]byte{'G', 'C', Version + 1, byte(KindArrayList), 0}), KindArrayList)
+
+	goassert.True(t, errors.Is(err, ErrUnsupportedVersion))
+}
+
+func Test_ReadHeaderShouldReturnErrKindMismatch_GivenDifferentKind(t *testing.T) {
+	var buffer bytes.Buffer
+	WriteHeader(&buffer, KindHashSet, 1)
+
+	_, _, err := ReadHeader(&buffer, KindArrayList)
+
+	goassert.True(t, errors.Is(err, ErrKindMismatch))
+	goassert.Equal(t, "codec: collection kind mismatch: expected ArrayList but found HashSet", err.Error())
+}
+
+func Test_PreallocationSizeShouldCapUntrustedCount(t *testing.T) {
+	goassert.Equal(t, 10, PreallocationSize(10))
+	goassert.Equal(t, maxPreallocation, PreallocationSize(1<<40))
+}
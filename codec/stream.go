@@ -0,0 +1,121 @@
+package codec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+Writes the encoding of a single element to the given writer. Used by the streaming encoders of the
+collections, which take care of framing each element
+*/
+type ElementEncoder[T any] func(w io.Writer, element *T) error
+
+/*
+Reads a single element from the given reader. The reader is limited to the frame of the element, so the
+decoder sees io.EOF once the element has been fully read
+*/
+type ElementDecoder[T any] func(r io.Reader) (T, error)
+
+/*
+Writes a header (see WriteHeader) followed by "count" length-prefixed frames, each holding one element
+encoded by the given encoder, to the given writer. "forEach" must visit exactly "count" elements, which keeps
+only one encoded element in memory at a time. Returns the number of bytes written
+*/
+func WriteStream[T any](
+	w io.Writer,
+	kind Kind,
+	count int,
+	forEach func(do func(*T)),
+	encodeElement ElementEncoder[T],
+) (int64, error) {
+	written, err := WriteHeader(w, kind, count)
+	if err != nil {
+		return written, err
+	}
+
+	var frame bytes.Buffer
+	var prefix []byte
+	visited := 0
+	forEach(func(element *T) {
+		if err != nil {
+			return
+		}
+
+		frame.Reset()
+		if err = encodeElement(&frame, element); err != nil {
+			err = fmt.Errorf("codec: encoding element %d: %w", visited, err)
+			return
+		}
+
+		prefix = appendUvarint(prefix[:0], uint64(frame.Len()))
+		n, writeErr := w.Write(prefix)
+		written += int64(n)
+		if writeErr != nil {
+			err = writeErr
+			return
+		}
+
+		m, writeErr := frame.WriteTo(w)
+		written += m
+		if writeErr != nil {
+			err = writeErr
+			return
+		}
+
+		visited++
+	})
+
+	if err == nil && visited != count {
+		err = fmt.Errorf("codec: expected to write %d elements but the collection had %d", count, visited)
+	}
+
+	return written, err
+}
+
+/*
+Reads a stream written by WriteStream for the given kind of collection from the given reader, decodes each
+element with the given decoder and passes it to "add" as soon as it is decoded. Returns the number of bytes
+read. Returns an error wrapping ErrCorruptedPayload if a frame is truncated or is not fully consumed by the
+decoder, and an error wrapping the error of the decoder if it fails otherwise. No bytes past the end of the
+stream are consumed
+*/
+func ReadStream[T any](r io.Reader, kind Kind, decodeElement ElementDecoder[T], add func(T)) (int64, error) {
+	count, read, err := ReadHeader(r, kind)
+	if err != nil {
+		return read, err
+	}
+
+	reader := newCountingByteReader(r)
+	for i := 0; i < count; i++ {
+		length, err := readUvarint(reader)
+		if err != nil {
+			return read + reader.count, fmt.Errorf("%w: frame %d: %v", ErrCorruptedPayload, i, err)
+		}
+
+		frame := &io.LimitedReader{R: reader, N: int64(length)}
+		element, err := decodeElement(frame)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return read + reader.count, fmt.Errorf("%w: frame %d is truncated: %v", ErrCorruptedPayload, i, err)
+		}
+
+		if err != nil {
+			return read + reader.count, fmt.Errorf("codec: decoding element %d: %w", i, err)
+		}
+
+		if frame.N > 0 {
+			return read + reader.count, fmt.Errorf(
+				"%w: decoder left %d bytes of frame %d unread",
+				ErrCorruptedPayload,
+				frame.N,
+				i,
+			)
+		}
+
+		add(element)
+	}
+
+	return read + reader.count, nil
+}
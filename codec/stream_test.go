@@ -0,0 +1,116 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func forEachOf(elements []int) func(func(*int)) {
+	return func(do func(*int)) {
+		for i := range elements {
+			do(&elements[i])
+		}
+	}
+}
+
+func Test_WriteStreamShouldRoundTripWithReadStream(t *testing.T) {
+	elements := []int{10, 16, 14}
+	var buffer bytes.Buffer
+
+	written, err := WriteStream(&buffer, KindArrayList, len(elements), forEachOf(elements), testhelpers.EncodeInt)
+	goassert.Nil(t, err)
+	goassert.Equal(t, int64(buffer.Len()), written)
+
+	var decoded []int
+	read, err := ReadStream(&buffer, KindArrayList, testhelpers.DecodeInt, func(element int) {
+		decoded = append(decoded, element)
+	})
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, written, read)
+	goassert.DeepEqual(t, elements, decoded)
+}
+
+func Test_WriteStreamShouldFrameEachElementWithLengthPrefix(t *testing.T) {
+	var buffer bytes.Buffer
+
+	WriteStream(&buffer, KindArrayList, 1, forEachOf([]int{1}), testhelpers.EncodeInt)
+
+	goassert.DeepEqual(
+		t,
+		[]byte{'G', 'C', Version, byte(KindArrayList), 1, 8, 1, 0, 0, 0, 0, 0, 0, 0},
+		buffer.Bytes(),
+	)
+}
+
+func Test_WriteStreamShouldReturnError_WhenEncoderFails(t *testing.T) {
+	failure := errors.New("failure")
+	encoded := 0
+
+	failingEncoder := func(w io.Writer, element *int) error {
+		encoded++
+		return failure
+	}
+
+	_, err := WriteStream(&bytes.Buffer{}, KindArrayList, 3, forEachOf([]int{1, 2, 3}), failingEncoder)
+
+	goassert.True(t, errors.Is(err, failure))
+	goassert.Equal(t, 1, encoded)
+}
+
+func Test_WriteStreamShouldReturnError_WhenCountDoesNotMatchVisitedElements(t *testing.T) {
+	_, err := WriteStream(&bytes.Buffer{}, KindArrayList, 3, forEachOf([]int{1}), testhelpers.EncodeInt)
+
+	goassert.NotNil(t, err)
+}
+
+func Test_ReadStreamShouldNotConsumeBytesPastEndOfStream(t *testing.T) {
+	var buffer bytes.Buffer
+	WriteStream(&buffer, KindHashSet, 2, forEachOf([]int{1, 2}), testhelpers.EncodeInt)
+	buffer.WriteString("next")
+
+	_, err := ReadStream(&buffer, KindHashSet, testhelpers.DecodeInt, func(int) {})
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "next", buffer.String())
+}
+
+func Test_ReadStreamShouldReturnErrCorruptedPayload_GivenTruncatedStream(t *testing.T) {
+	var buffer bytes.Buffer
+	WriteStream(&buffer, KindArrayList, 2, forEachOf([]int{1, 2}), testhelpers.EncodeInt)
+	truncated := buffer.Bytes()[:buffer.Len()-3]
+
+	added := 0
+	_, err := ReadStream(bytes.NewReader(truncated), KindArrayList, testhelpers.DecodeInt, func(int) { added++ })
+
+	goassert.True(t, errors.Is(err, ErrCorruptedPayload))
+	goassert.Equal(t, 1, added)
+}
+
+func Test_ReadStreamShouldReturnErrCorruptedPayload_WhenDecoderLeavesBytesInFrame(t *testing.T) {
+	var buffer bytes.Buffer
+	WriteStream(&buffer, KindArrayList, 1, forEachOf([]int{1}), testhelpers.EncodeInt)
+
+	_, err := ReadStream(&buffer, KindArrayList, func(r io.Reader) (int, error) {
+		var element int32
+		err := binary.Read(r, binary.LittleEndian, &element)
+		return int(element), err
+	}, func(int) {})
+
+	goassert.True(t, errors.Is(err, ErrCorruptedPayload))
+}
+
+func Test_ReadStreamShouldReturnErrKindMismatch_GivenStreamOfOtherKind(t *testing.T) {
+	var buffer bytes.Buffer
+	WriteStream(&buffer, KindArrayList, 0, forEachOf(nil), testhelpers.EncodeInt)
+
+	_, err := ReadStream(&buffer, KindArrayStack, testhelpers.DecodeInt, func(int) {})
+
+	goassert.True(t, errors.Is(err, ErrKindMismatch))
+}
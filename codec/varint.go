@@ -0,0 +1,51 @@
+package codec
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+func appendUvarint(dst []byte, value uint64) []byte {
+	var buffer [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buffer[:], value)
+
+	return append(dst, buffer[:n]...)
+}
+
+func readUvarint(r io.ByteReader) (uint64, error) {
+	value, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return value, err
+}
+
+/*
+Adapts an io.Reader into an io.ByteReader without reading ahead, so that no bytes past the ones requested are
+consumed from the underlying reader. Keeps track of the number of bytes read
+*/
+type countingByteReader struct {
+	reader io.Reader
+	count  int64
+	single [1]byte
+}
+
+func newCountingByteReader(r io.Reader) *countingByteReader {
+	return &countingByteReader{reader: r}
+}
+
+func (r *countingByteReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+
+	return n, err
+}
+
+func (r *countingByteReader) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(r, r.single[:]); err != nil {
+		return 0, err
+	}
+
+	return r.single[0], nil
+}
@@ -0,0 +1,171 @@
+package comparer
+
+/*
+Constraint satisfied by every type that supports the <, <=, >= and > operators
+*/
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+/*
+Compares the two given values. Returns a negative number if a is ordered before b, zero if a and b are ordered
+equally and a positive number if a is ordered after b. Comparators created by this package return exactly -1, 0
+or 1
+*/
+type Comparator[T any] func(a *T, b *T) int
+
+/*
+Returns a Comparator which orders values of an Ordered type in their natural ascending order. NaN values are
+ordered before every other floating point value and are equal to each other
+*/
+func Natural[K Ordered]() Comparator[K] {
+	return func(a *K, b *K) int {
+		aIsNaN := *a != *a
+		bIsNaN := *b != *b
+		switch {
+		case aIsNaN && bIsNaN:
+			return 0
+		case aIsNaN || *a < *b:
+			return -1
+		case bIsNaN || *a > *b:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+/*
+Returns a Comparator which orders values by the keys extracted from them with the given function in their
+natural ascending order
+*/
+func ComparingBy[T any, K Ordered](key func(*T) K) Comparator[T] {
+	return ComparingByWith(key, Natural[K]())
+}
+
+/*
+Returns a Comparator which orders values by the keys extracted from them with the given function, using the given
+Comparator to compare the keys
+*/
+func ComparingByWith[T any, K any](key func(*T) K, compare Comparator[K]) Comparator[T] {
+	return func(a *T, b *T) int {
+		aKey := key(a)
+		bKey := key(b)
+
+		return compare(&aKey, &bKey)
+	}
+}
+
+/*
+Returns a Comparator for pointers which orders nil before every non-nil pointer and compares the values of two
+non-nil pointers with the given Comparator
+*/
+func NilsFirst[T any](compare Comparator[T]) Comparator[*T] {
+	return nilsOrdered(compare, -1)
+}
+
+/*
+Returns a Comparator for pointers which orders nil after every non-nil pointer and compares the values of two
+non-nil pointers with the given Comparator
+*/
+func NilsLast[T any](compare Comparator[T]) Comparator[*T] {
+	return nilsOrdered(compare, 1)
+}
+
+func nilsOrdered[T any](compare Comparator[T], nilOrder int) Comparator[*T] {
+	return func(a **T, b **T) int {
+		switch {
+		case *a == nil && *b == nil:
+			return 0
+		case *a == nil:
+			return nilOrder
+		case *b == nil:
+			return -nilOrder
+		default:
+			return sign(compare(*a, *b))
+		}
+	}
+}
+
+/*
+Returns a Comparator built from the given less function, which returns true if a is ordered before b
+*/
+func FromLess[T any](less func(a *T, b *T) bool) Comparator[T] {
+	return func(a *T, b *T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+/*
+Returns a Comparator which orders values in the reverse order of the Comparator
+*/
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a *T, b *T) int {
+		return -sign(c(a, b))
+	}
+}
+
+/*
+Returns a Comparator which orders values with the Comparator first and with the given Comparator when the
+Comparator orders the two values equally
+*/
+func (c Comparator[T]) ThenComparing(next Comparator[T]) Comparator[T] {
+	return func(a *T, b *T) int {
+		if order := c(a, b); order != 0 {
+			return sign(order)
+		}
+
+		return sign(next(a, b))
+	}
+}
+
+/*
+Returns a function which returns true if a is ordered before b. Passing the function to priorityqueue.New
+creates a PriorityQueue which dequeues the values in ascending order of the Comparator
+*/
+func (c Comparator[T]) Less() func(a *T, b *T) bool {
+	return func(a *T, b *T) bool {
+		return c(a, b) < 0
+	}
+}
+
+/*
+Returns a function which returns true if a is ordered after b. Passing the function to priorityqueue.New
+creates a PriorityQueue which dequeues the values in descending order of the Comparator
+*/
+func (c Comparator[T]) Greater() func(a *T, b *T) bool {
+	return func(a *T, b *T) bool {
+		return c(a, b) > 0
+	}
+}
+
+/*
+Returns a function which returns true if a and b are ordered equally. The function can be passed to
+SetEqualityComparer of any collection
+*/
+func (c Comparator[T]) Equals() func(a *T, b *T) bool {
+	return func(a *T, b *T) bool {
+		return c(a, b) == 0
+	}
+}
+
+func sign(order int) int {
+	switch {
+	case order < 0:
+		return -1
+	case order > 0:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,99 @@
+package comparer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func compareValues[T any](compare Comparator[T], a T, b T) int {
+	return compare(&a, &b)
+}
+
+func Test_NaturalShouldOrderValuesAscending(t *testing.T) {
+	compare := Natural[int]()
+
+	goassert.Equal(t, -1, compareValues(compare, 1, 2))
+	goassert.Equal(t, 0, compareValues(compare, 2, 2))
+	goassert.Equal(t, 1, compareValues(compare, 3, 2))
+	goassert.Equal(t, -1, compareValues(Natural[string](), "a", "b"))
+}
+
+func Test_NaturalShouldOrderNaNBeforeOtherFloats(t *testing.T) {
+	compare := Natural[float64]()
+	nan := math.NaN()
+
+	goassert.Equal(t, -1, compareValues(compare, nan, math.Inf(-1)))
+	goassert.Equal(t, 1, compareValues(compare, 0, nan))
+	goassert.Equal(t, 0, compareValues(compare, nan, nan))
+}
+
+func Test_ReverseShouldInvertOrder(t *testing.T) {
+	compare := Natural[int]().Reverse()
+
+	goassert.Equal(t, 1, compareValues(compare, 1, 2))
+	goassert.Equal(t, 0, compareValues(compare, 2, 2))
+	goassert.Equal(t, -1, compareValues(compare, 3, 2))
+}
+
+func Test_ComparingByShouldOrderByExtractedKey(t *testing.T) {
+	compare := ComparingBy(func(p *person) int { return p.age })
+
+	goassert.Equal(t, -1, compareValues(compare, person{"b", 20}, person{"a", 30}))
+	goassert.Equal(t, 0, compareValues(compare, person{"b", 20}, person{"a", 20}))
+}
+
+func Test_ThenComparingShouldBreakTies_WithNextComparator(t *testing.T) {
+	compare := ComparingBy(func(p *person) int { return p.age }).
+		ThenComparing(ComparingBy(func(p *person) string { return p.name }).Reverse())
+
+	goassert.Equal(t, -1, compareValues(compare, person{"a", 20}, person{"b", 30}))
+	goassert.Equal(t, -1, compareValues(compare, person{"b", 20}, person{"a", 20}))
+	goassert.Equal(t, 0, compareValues(compare, person{"a", 20}, person{"a", 20}))
+}
+
+func Test_NilsFirstShouldOrderNilBeforeNonNil(t *testing.T) {
+	compare := NilsFirst(Natural[int]())
+	one, two := 1, 2
+
+	goassert.Equal(t, -1, compareValues(compare, nil, &one))
+	goassert.Equal(t, 1, compareValues(compare, &one, nil))
+	goassert.Equal(t, 0, compareValues[*int](compare, nil, nil))
+	goassert.Equal(t, -1, compareValues(compare, &one, &two))
+}
+
+func Test_NilsLastShouldOrderNilAfterNonNil(t *testing.T) {
+	compare := NilsLast(Natural[int]())
+	one, two := 1, 2
+
+	goassert.Equal(t, 1, compareValues(compare, nil, &one))
+	goassert.Equal(t, -1, compareValues(compare, &one, nil))
+	goassert.Equal(t, 0, compareValues[*int](compare, nil, nil))
+	goassert.Equal(t, 1, compareValues(compare, &two, &one))
+}
+
+func Test_FromLessShouldCreateEquivalentComparator(t *testing.T) {
+	compare := FromLess(func(a *int, b *int) bool { return *a < *b })
+
+	goassert.Equal(t, -1, compareValues(compare, 1, 2))
+	goassert.Equal(t, 0, compareValues(compare, 2, 2))
+	goassert.Equal(t, 1, compareValues(compare, 3, 2))
+}
+
+func Test_LessAndGreaterAndEqualsShouldAdaptComparator(t *testing.T) {
+	compare := Natural[int]()
+	one, two := 1, 2
+
+	goassert.True(t, compare.Less()(&one, &two))
+	goassert.False(t, compare.Less()(&two, &one))
+	goassert.True(t, compare.Greater()(&two, &one))
+	goassert.False(t, compare.Greater()(&one, &one))
+	goassert.True(t, compare.Equals()(&one, &one))
+	goassert.False(t, compare.Equals()(&one, &two))
+}
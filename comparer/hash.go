@@ -0,0 +1,134 @@
+package comparer
+
+import (
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+/*
+Returns a hash of the given value. Integers, floats, booleans and strings are hashed from their values directly.
+Structs, arrays and interfaces are hashed field by field, element by element and from their dynamic type and
+value, so values which are equal with == always hash equally, including floats equal to -0.0. Pointers, channels
+and unsafe pointers are hashed by address, so only the hashes of values containing none of them are stable across
+program runs and can be persisted or shared between processes
+*/
+func DefaultHash[K comparable](value *K) uint64 {
+	switch v := any(*value).(type) {
+	case string:
+		hasher := fnv.New64a()
+		hasher.Write([]byte(v))
+		return hasher.Sum64()
+	case bool:
+		if v {
+			return 1
+		}
+		return 0
+	case int:
+		return hashUint64(uint64(v))
+	case int8:
+		return hashUint64(uint64(v))
+	case int16:
+		return hashUint64(uint64(v))
+	case int32:
+		return hashUint64(uint64(v))
+	case int64:
+		return hashUint64(uint64(v))
+	case uint:
+		return hashUint64(uint64(v))
+	case uint8:
+		return hashUint64(uint64(v))
+	case uint16:
+		return hashUint64(uint64(v))
+	case uint32:
+		return hashUint64(uint64(v))
+	case uint64:
+		return hashUint64(v)
+	case uintptr:
+		return hashUint64(uint64(v))
+	case float32:
+		return hashFloat(float64(v))
+	case float64:
+		return hashFloat(v)
+	}
+
+	hasher := fnv.New64a()
+	writeValue(hasher, reflect.ValueOf(value).Elem())
+
+	return hasher.Sum64()
+}
+
+/*
+Writes the given value to the hasher. Integers and floats are written as 8 little endian bytes, the same bytes
+hashUint64 hashes, so a named integer type hashes like the integer it is based on
+*/
+func writeValue(hasher hash.Hash64, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint64(hasher, 1)
+		} else {
+			writeUint64(hasher, 0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(hasher, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(hasher, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint64(hasher, floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		writeUint64(hasher, floatBits(real(v.Complex())))
+		writeUint64(hasher, floatBits(imag(v.Complex())))
+	case reflect.String:
+		// the length keeps adjacent strings of a struct from running into each other, so {"ab", "c"} and
+		// {"a", "bc"} hash differently
+		writeUint64(hasher, uint64(v.Len()))
+		hasher.Write([]byte(v.String()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeValue(hasher, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeValue(hasher, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint64(hasher, 0)
+			return
+		}
+		hasher.Write([]byte(v.Elem().Type().String()))
+		writeValue(hasher, v.Elem())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(hasher, uint64(v.Pointer()))
+	}
+}
+
+func writeUint64(hasher hash.Hash64, v uint64) {
+	var buffer [8]byte
+	for i := range buffer {
+		buffer[i] = byte(v >> (8 * i))
+	}
+	hasher.Write(buffer[:])
+}
+
+// 0.0 and -0.0 are equal, so they must hash equally
+func floatBits(v float64) uint64 {
+	if v == 0 {
+		return 0
+	}
+
+	return math.Float64bits(v)
+}
+
+func hashFloat(v float64) uint64 {
+	return hashUint64(floatBits(v))
+}
+
+func hashUint64(v uint64) uint64 {
+	hasher := fnv.New64a()
+	writeUint64(hasher, v)
+
+	return hasher.Sum64()
+}
@@ -0,0 +1,65 @@
+package comparer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+type point struct {
+	x int
+	y int
+}
+
+type sample struct {
+	weight   float64
+	position [2]float32
+	label    string
+	note     string
+}
+
+type id int
+
+func hashValue[K comparable](value K) uint64 {
+	return DefaultHash(&value)
+}
+
+func Test_DefaultHashShouldReturnEqualHashes_GivenEqualValues(t *testing.T) {
+	goassert.Equal(t, hashValue(10), hashValue(10))
+	goassert.Equal(t, hashValue("abc"), hashValue("abc"))
+	goassert.Equal(t, hashValue(point{1, 2}), hashValue(point{1, 2}))
+	goassert.Equal(t, hashValue(0.0), hashValue(math.Copysign(0, -1)))
+}
+
+func Test_DefaultHashShouldReturnDifferentHashes_GivenDifferentValues(t *testing.T) {
+	goassert.NotEqual(t, hashValue(10), hashValue(16))
+	goassert.NotEqual(t, hashValue("abc"), hashValue("abd"))
+	goassert.NotEqual(t, hashValue(point{1, 2}), hashValue(point{2, 1}))
+	goassert.NotEqual(t, hashValue(true), hashValue(false))
+}
+
+func Test_DefaultHashShouldReturnEqualHashes_GivenEqualCompositeValuesWithNegativeZero(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+	positive := sample{weight: 0, position: [2]float32{0, 1}, label: "a"}
+	negative := sample{weight: negativeZero, position: [2]float32{float32(negativeZero), 1}, label: "a"}
+
+	goassert.True(t, positive == negative)
+	goassert.Equal(t, hashValue(positive), hashValue(negative))
+	goassert.Equal(t, hashValue([2]float64{0, 1}), hashValue([2]float64{negativeZero, 1}))
+}
+
+func Test_DefaultHashShouldReturnDifferentHashes_GivenDifferentCompositeValues(t *testing.T) {
+	goassert.NotEqual(t, hashValue(sample{label: "ab", note: "c"}), hashValue(sample{label: "a", note: "bc"}))
+	goassert.NotEqual(t, hashValue(sample{weight: 1}), hashValue(sample{weight: 2}))
+	goassert.NotEqual(t, hashValue([2]int{1, 2}), hashValue([2]int{2, 1}))
+}
+
+func Test_DefaultHashShouldHashNamedTypesLikeTheirUnderlyingType(t *testing.T) {
+	goassert.Equal(t, hashValue(10), hashValue(id(10)))
+}
+
+func Test_DefaultHashShouldBeStable(t *testing.T) {
+	// FNV-1a of the empty string, independent of the process the hash is computed in
+	goassert.Equal(t, uint64(14695981039346656037), hashValue(""))
+}
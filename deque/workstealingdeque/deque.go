@@ -0,0 +1,169 @@
+package workstealingdeque
+
+import "sync/atomic"
+
+const defaultCapacity = 32
+
+type ring[T any] struct {
+	mask  int64
+	slots []atomic.Pointer[T]
+}
+
+func newRing[T any](capacity int64) *ring[T] {
+	return &ring[T]{
+		mask:  capacity - 1,
+		slots: make([]atomic.Pointer[T], capacity),
+	}
+}
+
+func (r *ring[T]) capacity() int64 {
+	return r.mask + 1
+}
+
+func (r *ring[T]) get(index int64) *T {
+	return r.slots[index&r.mask].Load()
+}
+
+func (r *ring[T]) put(index int64, element *T) {
+	r.slots[index&r.mask].Store(element)
+}
+
+func (r *ring[T]) grow(bottom int64, top int64) *ring[T] {
+	grown := newRing[T](r.capacity() << 1)
+	for i := top; i < bottom; i++ {
+		grown.put(i, r.get(i))
+	}
+
+	return grown
+}
+
+/*
+Chase-Lev work-stealing deque. The goroutine that owns the Deque pushes and pops elements at the bottom end
+without taking any locks, while any number of other goroutines (thieves) steal elements from the top end
+using compare-and-swap. The internal circular buffer grows automatically and is never shrunk.
+Push and Pop must only be called by the owner goroutine. Steal, Size and Empty are safe to call from any
+goroutine.
+A Deque must not be copied after first use, which is why its constructor returns a pointer
+*/
+type Deque[T any] struct {
+	top    atomic.Int64
+	bottom atomic.Int64
+	buffer atomic.Pointer[ring[T]]
+}
+
+/*
+Creates a new instance of empty Deque and returns pointer to the instance
+*/
+func New[T any]() *Deque[T] {
+	return NewWithCapacity[T](defaultCapacity)
+}
+
+/*
+Creates a new instance of empty Deque whose internal buffer can initially hold the given number of elements
+and returns pointer to the instance. The capacity is rounded up to the next power of two
+*/
+func NewWithCapacity[T any](capacity int) *Deque[T] {
+	size := int64(1)
+	for size < int64(capacity) {
+		size <<= 1
+	}
+
+	d := &Deque[T]{}
+	d.buffer.Store(newRing[T](size))
+
+	return d
+}
+
+/*
+Returns the number of elements in the Deque at the time of the call. When thieves are stealing concurrently,
+the returned value is only a snapshot and may already be stale when it is returned
+*/
+func (d *Deque[T]) Size() int {
+	size := d.bottom.Load() - d.top.Load()
+	if size < 0 {
+		return 0
+	}
+
+	return int(size)
+}
+
+/*
+Returns true if the Deque is empty at the time of the call. Otherwise, false
+*/
+func (d *Deque[T]) Empty() bool {
+	return d.Size() == 0
+}
+
+/*
+Pushes the given value to the bottom of the Deque. Must only be called by the owner goroutine
+*/
+func (d *Deque[T]) Push(element T) {
+	bottom := d.bottom.Load()
+	top := d.top.Load()
+	buffer := d.buffer.Load()
+
+	if bottom-top >= buffer.capacity()-1 {
+		buffer = buffer.grow(bottom, top)
+		d.buffer.Store(buffer)
+	}
+
+	buffer.put(bottom, &element)
+	d.bottom.Store(bottom + 1)
+}
+
+/*
+Removes the most recently pushed element from the bottom of the Deque and returns it along with true.
+Returns the zero value and false if the Deque is empty or the last element was stolen concurrently.
+Must only be called by the owner goroutine
+*/
+func (d *Deque[T]) Pop() (T, bool) {
+	var zero T
+
+	bottom := d.bottom.Load() - 1
+	buffer := d.buffer.Load()
+	d.bottom.Store(bottom)
+
+	top := d.top.Load()
+	if top > bottom {
+		d.bottom.Store(bottom + 1)
+		return zero, false
+	}
+
+	element := buffer.get(bottom)
+	if top < bottom {
+		buffer.put(bottom, nil)
+		return *element, true
+	}
+
+	// only one element is left, so the owner races the thieves for it
+	won := d.top.CompareAndSwap(top, top+1)
+	d.bottom.Store(bottom + 1)
+	if !won {
+		return zero, false
+	}
+
+	buffer.put(bottom, nil)
+	return *element, true
+}
+
+/*
+Removes the least recently pushed element from the top of the Deque and returns it along with true.
+Returns the zero value and false if the Deque is empty. Retries internally when it loses a race against
+other thieves or the owner, so a false result always means the Deque was observed empty.
+Safe to call from any goroutine
+*/
+func (d *Deque[T]) Steal() (T, bool) {
+	for {
+		top := d.top.Load()
+		bottom := d.bottom.Load()
+		if top >= bottom {
+			var zero T
+			return zero, false
+		}
+
+		element := d.buffer.Load().get(top)
+		if d.top.CompareAndSwap(top, top+1) {
+			return *element, true
+		}
+	}
+}
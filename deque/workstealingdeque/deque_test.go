@@ -0,0 +1,200 @@
+package workstealingdeque
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_NewShouldCreateEmptyDeque(t *testing.T) {
+	deque := New[int]()
+
+	goassert.True(t, deque.Empty())
+	goassert.Equal(t, 0, deque.Size())
+	goassert.Equal(t, int64(defaultCapacity), deque.buffer.Load().capacity())
+}
+
+func Test_NewWithCapacityShouldRoundCapacityUpToPowerOfTwo(t *testing.T) {
+	deque := NewWithCapacity[int](100)
+
+	goassert.Equal(t, int64(128), deque.buffer.Load().capacity())
+}
+
+func Test_PushShouldAddElementsToBottom(t *testing.T) {
+	deque := New[int]()
+
+	deque.Push(10)
+	deque.Push(16)
+	deque.Push(14)
+
+	goassert.Equal(t, 3, deque.Size())
+	goassert.False(t, deque.Empty())
+}
+
+func Test_PushShouldGrowBuffer_WhenBufferIsFull(t *testing.T) {
+	deque := NewWithCapacity[int](4)
+
+	for i := 0; i < 10; i++ {
+		deque.Push(i)
+	}
+
+	goassert.Equal(t, 10, deque.Size())
+	goassert.Equal(t, int64(16), deque.buffer.Load().capacity())
+	for i := 9; i >= 0; i-- {
+		element, ok := deque.Pop()
+		goassert.True(t, ok)
+		goassert.Equal(t, i, element)
+	}
+}
+
+func Test_PopShouldReturnMostRecentlyPushedElement(t *testing.T) {
+	deque := New[int]()
+	deque.Push(10)
+	deque.Push(16)
+
+	element, ok := deque.Pop()
+
+	goassert.True(t, ok)
+	goassert.Equal(t, 16, element)
+	goassert.Equal(t, 1, deque.Size())
+}
+
+func Test_PopShouldReturnFalse_GivenEmptyDeque(t *testing.T) {
+	deque := New[int]()
+
+	element, ok := deque.Pop()
+
+	goassert.False(t, ok)
+	goassert.Equal(t, 0, element)
+	goassert.Equal(t, 0, deque.Size())
+}
+
+func Test_StealShouldReturnLeastRecentlyPushedElement(t *testing.T) {
+	deque := New[int]()
+	deque.Push(10)
+	deque.Push(16)
+
+	element, ok := deque.Steal()
+
+	goassert.True(t, ok)
+	goassert.Equal(t, 10, element)
+	goassert.Equal(t, 1, deque.Size())
+}
+
+func Test_StealShouldReturnFalse_GivenEmptyDeque(t *testing.T) {
+	deque := New[int]()
+	deque.Push(10)
+	deque.Pop()
+
+	element, ok := deque.Steal()
+
+	goassert.False(t, ok)
+	goassert.Equal(t, 0, element)
+}
+
+func Test_PopAndStealShouldShareElementsWithoutOverlap(t *testing.T) {
+	deque := New[int]()
+	deque.Push(1)
+	deque.Push(2)
+	deque.Push(3)
+
+	stolen, _ := deque.Steal()
+	popped, _ := deque.Pop()
+	last, _ := deque.Steal()
+
+	goassert.Equal(t, 1, stolen)
+	goassert.Equal(t, 3, popped)
+	goassert.Equal(t, 2, last)
+	goassert.True(t, deque.Empty())
+}
+
+/*
+Runs the owner and the given number of thieves concurrently. The owner pushes "elements" consecutive integers
+and pops one element after every "popEvery" pushes, then drains the deque. Every element is recorded by
+whichever goroutine took it, and the harness verifies that each element was taken exactly once
+*/
+func stressDeque(t *testing.T, thieves int, elements int, popEvery int) {
+	t.Helper()
+
+	deque := NewWithCapacity[int](2)
+	taken := make([][]int, thieves+1)
+
+	var done sync.WaitGroup
+	var finished sync.WaitGroup
+	stop := make(chan struct{})
+
+	for thief := 1; thief <= thieves; thief++ {
+		done.Add(1)
+		go func(thief int) {
+			defer done.Done()
+			for {
+				if element, ok := deque.Steal(); ok {
+					taken[thief] = append(taken[thief], element)
+					continue
+				}
+
+				select {
+				case <-stop:
+					return
+				default:
+					runtime.Gosched()
+				}
+			}
+		}(thief)
+	}
+
+	finished.Add(1)
+	go func() {
+		defer finished.Done()
+		for i := 0; i < elements; i++ {
+			deque.Push(i)
+			if i%popEvery == 0 {
+				if element, ok := deque.Pop(); ok {
+					taken[0] = append(taken[0], element)
+				}
+			}
+		}
+
+		for {
+			element, ok := deque.Pop()
+			if !ok {
+				break
+			}
+			taken[0] = append(taken[0], element)
+		}
+	}()
+
+	finished.Wait()
+	for !deque.Empty() {
+		runtime.Gosched()
+	}
+	close(stop)
+	done.Wait()
+
+	seen := make([]int, elements)
+	for _, elementsTaken := range taken {
+		for _, element := range elementsTaken {
+			seen[element]++
+		}
+	}
+
+	for element, count := range seen {
+		if count != 1 {
+			t.Fatalf("Expected element %d to be taken exactly once but it was taken %d times", element, count)
+		}
+	}
+}
+
+func Test_DequeShouldNotLoseOrDuplicateElements_GivenSingleThief(t *testing.T) {
+	stressDeque(t, 1, 100000, 3)
+}
+
+func Test_DequeShouldNotLoseOrDuplicateElements_GivenManyThieves(t *testing.T) {
+	stressDeque(t, 8, 200000, 5)
+}
+
+func Test_DequeShouldNotLoseOrDuplicateElements_WhenOwnerPopsOften(t *testing.T) {
+	stressDeque(t, 4, 100000, 1)
+}
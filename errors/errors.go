@@ -0,0 +1,67 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+Sentinel errors returned by the Try* methods of every collection. The panics of the non-Try methods carry
+errors wrapping the same sentinels, so errors.Is can be used on the value returned by recover()
+*/
+var (
+	/* The operation requires at least one element but the collection is empty */
+	ErrEmpty = errors.New("collection is empty")
+
+	/* The given index is outside of the valid range of indexes of the collection */
+	ErrIndexOutOfRange = errors.New("index out of range")
+
+	/* The operation compares elements but no equality comparer was set */
+	ErrNoEqualityComparer = errors.New("equality comparer is not set")
+
+	/* The operation orders elements but no compare function was set */
+	ErrNoComparator = errors.New("compare function is not set")
+
+	/* The given start and end indexes do not form a valid range */
+	ErrInvalidRange = errors.New("invalid range")
+
+	/* The given argument is outside of the values accepted by the function, such as a too small heap arity */
+	ErrInvalidArgument = errors.New("invalid argument")
+
+	/* The operation modifies a collection which is read-only */
+	ErrUnmodifiable = errors.New("collection is unmodifiable")
+
+	/* The given key does not exist in the collection */
+	ErrKeyNotFound = errors.New("key not found")
+
+	/* The internal state of the collection is inconsistent, as reported by a Validate method */
+	ErrInvariantViolated = errors.New("collection invariant is violated")
+
+	/* The given elements were expected to be sorted but are not */
+	ErrNotSorted = errors.New("elements are not sorted")
+)
+
+type collectionError struct {
+	message  string
+	sentinel error
+}
+
+func (e *collectionError) Error() string {
+	return e.message
+}
+
+func (e *collectionError) Unwrap() error {
+	return e.sentinel
+}
+
+/*
+Returns a new error with the given formatted message which wraps the given sentinel error, so that
+errors.Is(err, sentinel) returns true. Unlike fmt.Errorf with the %w verb, the message of the sentinel is not
+appended to the given message
+*/
+func Newf(sentinel error, format string, args ...interface{}) error {
+	return &collectionError{
+		message:  fmt.Sprintf(format, args...),
+		sentinel: sentinel,
+	}
+}
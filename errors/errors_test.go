@@ -0,0 +1,38 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_NewfShouldFormatMessageWithoutSentinelMessage(t *testing.T) {
+	err := Newf(ErrIndexOutOfRange, "index %d is out of range", 5)
+
+	goassert.Equal(t, "index 5 is out of range", err.Error())
+}
+
+func Test_NewfShouldWrapGivenSentinel(t *testing.T) {
+	err := Newf(ErrEmpty, "queue is empty")
+
+	goassert.True(t, errors.Is(err, ErrEmpty))
+	goassert.False(t, errors.Is(err, ErrIndexOutOfRange))
+}
+
+func Test_NewfShouldWrapGivenSentinel_WhenWrappedAgain(t *testing.T) {
+	err := fmt.Errorf("outer: %w", Newf(ErrInvalidRange, "bad range"))
+
+	goassert.True(t, errors.Is(err, ErrInvalidRange))
+}
+
+func Test_NewfShouldBeRecoverableWithErrorsIs_WhenUsedAsPanicValue(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		goassert.True(t, ok)
+		goassert.True(t, errors.Is(err, ErrNoEqualityComparer))
+	}()
+
+	panic(Newf(ErrNoEqualityComparer, "no comparer"))
+}
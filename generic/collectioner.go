@@ -1,12 +1,26 @@
 package generic
 
-type Collectioner[T any] interface {
+/*
+Read operations shared by all collections. A ReadOnlyCollectioner can be passed to callers which must not
+mutate the collection
+*/
+type ReadOnlyCollectioner[T any] interface {
 	/* Returns the size of the collection */
 	Size() int
 
 	/* Returns true if the collection is empty. Otherwise, return false */
 	Empty() bool
 
+	/* Returns true if the given value was found in the collection. Otherwise, false */
+	Contains(element T) bool
+
+	/* Iterates through each element in the collection and executes the given function */
+	ForEach(do func(*T))
+}
+
+type Collectioner[T any] interface {
+	ReadOnlyCollectioner[T]
+
 	/*
 		Adds the given value to the collection. Returns true if the value was added successfully. Otherwise,
 		false
@@ -18,9 +32,15 @@ type Collectioner[T any] interface {
 	   Otherwise, false
 	*/
 	Remove(element T) bool
+}
 
-	/* Returns true if the given value was found in the collection. Otherwise, false */
-	Contains(element T) bool
+/*
+Subset of the methods of Collectioner which only reads the elements. Every collection and every collection
+interface of this library satisfies Traversable
+*/
+type Traversable[T any] interface {
+	/* Returns the size of the collection */
+	Size() int
 
 	/* Iterates through each element in the collection and executes the given function */
 	ForEach(do func(*T))
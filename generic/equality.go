@@ -0,0 +1,180 @@
+package generic
+
+const (
+	hashOffset uint64 = 14695981039346656037
+	hashPrime  uint64 = 1099511628211
+)
+
+/*
+Returns true if the two given collections have the same size and their ForEach methods visit equal elements in
+the same order. Elements are compared with the given equals function
+*/
+func SequenceEqual[T any](a Traversable[T], b Traversable[T], equals func(*T, *T) bool) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+
+	others := snapshot(b)
+	i := 0
+	equal := true
+	a.ForEach(func(element *T) {
+		if equal && !equals(element, &others[i]) {
+			equal = false
+		}
+		i++
+	})
+
+	return equal
+}
+
+/*
+Returns true if the two given collections contain the same elements the same number of times, regardless of
+order. Elements must be comparable
+*/
+func ElementsEqual[K comparable](a Traversable[K], b Traversable[K]) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+
+	counts := make(map[K]int, a.Size())
+	a.ForEach(func(element *K) {
+		counts[*element]++
+	})
+
+	equal := true
+	b.ForEach(func(element *K) {
+		if !equal {
+			return
+		}
+
+		count := counts[*element]
+		if count == 0 {
+			equal = false
+			return
+		}
+		counts[*element] = count - 1
+	})
+
+	return equal
+}
+
+/*
+Returns true if the two given collections contain the same elements the same number of times, regardless of
+order. Elements are compared with the given equals function, which takes quadratic time in the size of the
+collections
+*/
+func ElementsEqualFunc[T any](a Traversable[T], b Traversable[T], equals func(*T, *T) bool) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+
+	others := snapshot(b)
+	matched := make([]bool, len(others))
+	equal := true
+	a.ForEach(func(element *T) {
+		if !equal {
+			return
+		}
+
+		for i := range others {
+			if !matched[i] && equals(element, &others[i]) {
+				matched[i] = true
+				return
+			}
+		}
+		equal = false
+	})
+
+	return equal
+}
+
+/*
+Returns true if the two given collections contain the same elements the same number of times, regardless of
+order. Only elements with equal hashes are compared with the given equals function, which takes linear expected
+time instead of the quadratic time of ElementsEqualFunc. The hash function must be consistent with equals
+*/
+func ElementsEqualHashFunc[T any](
+	a Traversable[T],
+	b Traversable[T],
+	equals func(*T, *T) bool,
+	hash func(*T) uint64,
+) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+
+	buckets := make(map[uint64][]T, b.Size())
+	b.ForEach(func(element *T) {
+		code := hash(element)
+		buckets[code] = append(buckets[code], *element)
+	})
+
+	equal := true
+	a.ForEach(func(element *T) {
+		if !equal {
+			return
+		}
+
+		code := hash(element)
+		bucket := buckets[code]
+		for i := range bucket {
+			if equals(element, &bucket[i]) {
+				bucket[i] = bucket[len(bucket)-1]
+				buckets[code] = bucket[:len(bucket)-1]
+				return
+			}
+		}
+		equal = false
+	})
+
+	return equal
+}
+
+/*
+Returns a hash code of the given collection which depends on the order its ForEach method visits the elements.
+Elements are hashed with the given hash function. Collections equal by SequenceEqual have equal hash codes when
+the hash function is consistent with the equality comparer
+*/
+func OrderedHashCode[T any](c Traversable[T], hash func(*T) uint64) uint64 {
+	code := hashOffset
+	c.ForEach(func(element *T) {
+		code = (code ^ mix(hash(element))) * hashPrime
+	})
+
+	return code ^ mix(uint64(c.Size()))
+}
+
+/*
+Returns a hash code of the given collection which does not depend on the order of the elements. Elements are
+hashed with the given hash function. Collections equal by ElementsEqual have equal hash codes when the hash
+function is consistent with the equality comparer
+*/
+func UnorderedHashCode[T any](c Traversable[T], hash func(*T) uint64) uint64 {
+	code := hashOffset
+	c.ForEach(func(element *T) {
+		code += mix(hash(element))
+	})
+
+	return code ^ mix(uint64(c.Size()))
+}
+
+// copies the elements, since ForEach implementations may reuse the variable the visited pointers point to
+func snapshot[T any](c Traversable[T]) []T {
+	elements := make([]T, 0, c.Size())
+	c.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return elements
+}
+
+// finalizer of splitmix64, spreads the bits of similar element hashes before they are combined
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
@@ -0,0 +1,101 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+type sliceTraversable[T any] []T
+
+func (s sliceTraversable[T]) Size() int {
+	return len(s)
+}
+
+func (s sliceTraversable[T]) ForEach(do func(*T)) {
+	for i := range s {
+		do(&s[i])
+	}
+}
+
+func equals(a *int, b *int) bool {
+	return *a == *b
+}
+
+func hash(element *int) uint64 {
+	return uint64(*element)
+}
+
+func Test_SequenceEqualShouldReturnTrue_GivenEqualElementsInSameOrder(t *testing.T) {
+	goassert.True(t, SequenceEqual[int](sliceTraversable[int]{10, 16, 5}, sliceTraversable[int]{10, 16, 5}, equals))
+	goassert.True(t, SequenceEqual[int](sliceTraversable[int]{}, sliceTraversable[int]{}, equals))
+}
+
+func Test_SequenceEqualShouldReturnFalse_GivenDifferentOrderOrSize(t *testing.T) {
+	goassert.False(t, SequenceEqual[int](sliceTraversable[int]{10, 16, 5}, sliceTraversable[int]{10, 5, 16}, equals))
+	goassert.False(t, SequenceEqual[int](sliceTraversable[int]{10, 16}, sliceTraversable[int]{10, 16, 5}, equals))
+}
+
+func Test_ElementsEqualShouldUseMultisetSemantics(t *testing.T) {
+	goassert.True(t, ElementsEqual[int](sliceTraversable[int]{10, 16, 10}, sliceTraversable[int]{16, 10, 10}))
+	goassert.False(t, ElementsEqual[int](sliceTraversable[int]{10, 16, 10}, sliceTraversable[int]{16, 16, 10}))
+	goassert.False(t, ElementsEqual[int](sliceTraversable[int]{10, 16}, sliceTraversable[int]{16, 10, 10}))
+}
+
+func Test_ElementsEqualHashFuncShouldUseMultisetSemantics(t *testing.T) {
+	// a constant hash puts every element into the same bucket, so equals alone must tell them apart
+	constant := func(*int) uint64 { return 0 }
+	identity := func(element *int) uint64 { return uint64(*element) }
+
+	for _, hash := range []func(*int) uint64{constant, identity} {
+		goassert.True(t, ElementsEqualHashFunc[int](
+			sliceTraversable[int]{10, 16, 10},
+			sliceTraversable[int]{16, 10, 10},
+			equals,
+			hash,
+		))
+		goassert.False(t, ElementsEqualHashFunc[int](
+			sliceTraversable[int]{10, 16, 10},
+			sliceTraversable[int]{16, 16, 10},
+			equals,
+			hash,
+		))
+		goassert.False(t, ElementsEqualHashFunc[int](
+			sliceTraversable[int]{10, 16},
+			sliceTraversable[int]{16, 10, 10},
+			equals,
+			hash,
+		))
+	}
+}
+
+func Test_ElementsEqualFuncShouldUseMultisetSemantics(t *testing.T) {
+	goassert.True(t, ElementsEqualFunc[int](sliceTraversable[int]{10, 16, 10}, sliceTraversable[int]{16, 10, 10}, equals))
+	goassert.False(t, ElementsEqualFunc[int](sliceTraversable[int]{10, 16, 10}, sliceTraversable[int]{16, 16, 10}, equals))
+}
+
+func Test_OrderedHashCodeShouldDependOnOrder(t *testing.T) {
+	goassert.Equal(
+		t,
+		OrderedHashCode[int](sliceTraversable[int]{10, 16, 5}, hash),
+		OrderedHashCode[int](sliceTraversable[int]{10, 16, 5}, hash),
+	)
+	goassert.NotEqual(
+		t,
+		OrderedHashCode[int](sliceTraversable[int]{10, 16, 5}, hash),
+		OrderedHashCode[int](sliceTraversable[int]{5, 16, 10}, hash),
+	)
+}
+
+func Test_UnorderedHashCodeShouldNotDependOnOrder_ButOnMultiplicity(t *testing.T) {
+	goassert.Equal(
+		t,
+		UnorderedHashCode[int](sliceTraversable[int]{10, 16, 5}, hash),
+		UnorderedHashCode[int](sliceTraversable[int]{5, 16, 10}, hash),
+	)
+	goassert.NotEqual(
+		t,
+		UnorderedHashCode[int](sliceTraversable[int]{10, 10}, hash),
+		UnorderedHashCode[int](sliceTraversable[int]{10}, hash),
+	)
+}
@@ -0,0 +1,23 @@
+package generic
+
+/*
+Removes up to n elements from a collection holding "size" elements by calling the given remove function once per
+element, and returns the removed elements in the order they were removed. n is clamped between 0 and size, so
+remove is never called on an empty collection. Used by the bulk DequeueN and PopN methods
+*/
+func TakeN[T any](n int, size int, remove func() (T, error)) []T {
+	if n > size {
+		n = size
+	}
+	if n < 0 {
+		n = 0
+	}
+
+	elements := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		element, _ := remove()
+		elements = append(elements, element)
+	}
+
+	return elements
+}
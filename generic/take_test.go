@@ -0,0 +1,36 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_TakeNShouldRemoveGivenNumberOfElementsInOrder(t *testing.T) {
+	remaining := []int{10, 16, 5}
+	remove := func() (int, error) {
+		element := remaining[0]
+		remaining = remaining[1:]
+		return element, nil
+	}
+
+	taken := TakeN(2, len(remaining), remove)
+
+	goassert.DeepEqual(t, []int{10, 16}, taken)
+	goassert.DeepEqual(t, []int{5}, remaining)
+}
+
+func Test_TakeNShouldClampN_GivenNOutOfRange(t *testing.T) {
+	calls := 0
+	remove := func() (int, error) {
+		calls++
+		return calls, nil
+	}
+
+	all := TakeN(5, 3, remove)
+	none := TakeN(-1, 3, remove)
+
+	goassert.DeepEqual(t, []int{1, 2, 3}, all)
+	goassert.DeepEqual(t, []int{}, none)
+	goassert.Equal(t, 3, calls)
+}
@@ -1,9 +1,8 @@
 package arraylist
 
 import (
-	"fmt"
-
 	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/list"
 )
@@ -110,12 +109,29 @@ Retrieves and returns a reference to the element at the given index. Panics if t
 Implements lister.At
 */
 func (l *List[T]) At(index int) *T {
-	if !l.isValidIndex(index) {
-		err := fmt.Sprintf("List.At could not retrieve element because given index %d is out of range", index)
+	element, err := l.TryAt(index)
+	if err != nil {
 		panic(err)
 	}
 
-	return &l.container[index]
+	return element
+}
+
+/*
+Retrieves and returns a reference to the element at the given index. Returns an error wrapping
+errors.ErrIndexOutOfRange if the given index is out of range.
+Implements Lister.TryAt
+*/
+func (l *List[T]) TryAt(index int) (*T, error) {
+	if !l.isValidIndex(index) {
+		return nil, errors.Newf(
+			errors.ErrIndexOutOfRange,
+			"List.At could not retrieve element because given index %d is out of range",
+			index,
+		)
+	}
+
+	return &l.container[index], nil
 }
 
 /*
@@ -123,12 +139,27 @@ Sets the given value at the given index. Panics if the given index is out of ran
 Implements lister.Set
 */
 func (l *List[T]) Set(index int, value T) {
-	if !l.isValidIndex(index) {
-		err := fmt.Sprintf("List.Set could not set given value because given index %d is out of range", index)
+	if err := l.TrySet(index, value); err != nil {
 		panic(err)
 	}
+}
+
+/*
+Sets the given value at the given index. Returns an error wrapping errors.ErrIndexOutOfRange if the given index
+is out of range.
+Implements Lister.TrySet
+*/
+func (l *List[T]) TrySet(index int, value T) error {
+	if !l.isValidIndex(index) {
+		return errors.Newf(
+			errors.ErrIndexOutOfRange,
+			"List.Set could not set given value because given index %d is out of range",
+			index,
+		)
+	}
 
 	l.container[index] = value
+	return nil
 }
 
 /*
@@ -146,22 +177,53 @@ func (l *List[T]) Empty() bool {
 }
 
 /*
-Returns a reference to the first element (at index 0) in the list
+Returns a reference to the first element (at index 0) in the list. Panics if the list is empty
 */
 func (l *List[T]) Front() *T {
+	element, err := l.TryFront()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the first element (at index 0) in the list. Returns an error wrapping errors.ErrEmpty if
+the list is empty.
+Implements Lister.TryFront
+*/
+func (l *List[T]) TryFront() (*T, error) {
 	if l.size == 0 {
-		panic("ArrayList.Front failed because the list is empty")
+		return nil, errors.Newf(errors.ErrEmpty, "ArrayList.Front failed because the list is empty")
 	}
 
-	return &l.container[0]
+	return &l.container[0], nil
 }
 
+/*
+Returns a reference to the last element in the list. Panics if the list is empty
+*/
 func (l *List[T]) Back() *T {
+	element, err := l.TryBack()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the last element in the list. Returns an error wrapping errors.ErrEmpty if the list is
+empty.
+Implements Lister.TryBack
+*/
+func (l *List[T]) TryBack() (*T, error) {
 	if l.size == 0 {
-		panic("ArrayList.Back failed because the list is empty")
+		return nil, errors.Newf(errors.ErrEmpty, "ArrayList.Back failed because the list is empty")
 	}
 
-	return &l.container[l.size-1]
+	return &l.container[l.size-1], nil
 }
 
 /*
@@ -185,11 +247,22 @@ Removes the last element of the list.
 Implements Lister.RemoveBack
 */
 func (l *List[T]) RemoveBack() {
+	if err := l.TryRemoveBack(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the last element of the list. Returns an error wrapping errors.ErrEmpty if the list is empty.
+Implements Lister.TryRemoveBack
+*/
+func (l *List[T]) TryRemoveBack() error {
 	if l.size == 0 {
-		panic("ArrayList.RemoveBack failed because the list is empty")
+		return errors.Newf(errors.ErrEmpty, "ArrayList.RemoveBack failed because the list is empty")
 	}
 
 	l.size--
+	return nil
 }
 
 /*
@@ -207,13 +280,15 @@ func (l *List[T]) Insert(index int, element T) bool {
 		return true
 	}
 
-	l.container = append(l.container[:index+1], l.container[index:]...)
-	l.container[index] = element
-	l.size++
-	if l.size > l.cap {
+	if l.size == l.cap {
+		l.container = append(l.container, element)
 		l.cap++
 	}
 
+	copy(l.container[index+1:l.size+1], l.container[index:l.size])
+	l.container[index] = element
+	l.size++
+
 	return true
 }
 
@@ -230,7 +305,21 @@ Removes the first element of the list
 Implements Lister.RemoveFront
 */
 func (l *List[T]) RemoveFront() {
-	l.RemoveAt(0)
+	if err := l.TryRemoveFront(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the first element of the list. Returns an error wrapping errors.ErrEmpty if the list is empty.
+Implements Lister.TryRemoveFront
+*/
+func (l *List[T]) TryRemoveFront() error {
+	if l.size == 0 {
+		return errors.Newf(errors.ErrEmpty, "ArrayList.RemoveFront failed because the list is empty")
+	}
+
+	return l.TryRemoveAt(0)
 }
 
 /*
@@ -242,15 +331,35 @@ Panics if the equality comparer "equals" method is not set.
 Implements Lister.Remove and Collectioner.Remove
 */
 func (l *List[T]) Remove(element T) bool {
-	elementIndex := l.indexOf(element)
+	removed, err := l.TryRemove(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return removed
+}
+
+/*
+Removes the first occurrence of the given element from the List.
+Returns true if the element is found and removed. Returns false if the element to remove is not found.
+Returns an error wrapping errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements Lister.TryRemove
+*/
+func (l *List[T]) TryRemove(element T) (bool, error) {
+	elementIndex, err := l.indexOf(element)
+	if err != nil {
+		return false, err
+	}
+
 	if elementIndex == -1 {
-		return false
+		return false, nil
 	}
 
 	l.container = append(l.container[:elementIndex], l.container[elementIndex+1:]...)
 	l.size--
+	l.cap--
 
-	return true
+	return true, nil
 }
 
 /*
@@ -258,16 +367,30 @@ Removes the element at the given index from the List. Panics if the given index
 Implements Lister.RemoveAt
 */
 func (l *List[T]) RemoveAt(index int) {
+	if err := l.TryRemoveAt(index); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the element at the given index from the List. Returns an error wrapping errors.ErrIndexOutOfRange if
+the given index is out of range.
+Implements Lister.TryRemoveAt
+*/
+func (l *List[T]) TryRemoveAt(index int) error {
 	if !l.isValidIndex(index) {
-		err := fmt.Sprintf(
+		return errors.Newf(
+			errors.ErrIndexOutOfRange,
 			"ArrayList.RemoveAt cannot remove element at index %d because it is out of range",
 			index,
 		)
-		panic(err)
 	}
 
 	l.container = append(l.container[:index], l.container[index+1:]...)
 	l.size--
+	l.cap--
+
+	return nil
 }
 
 /*
@@ -278,6 +401,20 @@ or manually (through Lister.SetEqualityComparer).
 Implements Lister.IndexOf
 */
 func (l *List[T]) IndexOf(element T) int {
+	index, err := l.indexOf(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return index
+}
+
+/*
+Returns the index of the given element or -1 if the element is not found.
+Returns an error wrapping errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements Lister.TryIndexOf
+*/
+func (l *List[T]) TryIndexOf(element T) (int, error) {
 	return l.indexOf(element)
 }
 
@@ -288,21 +425,34 @@ or manually (through Lister.SetEqualityComparer).
 Implements Lister.Contains and Collectioner.Contains
 */
 func (l *List[T]) Contains(element T) bool {
-	return l.indexOf(element) != -1
+	return l.IndexOf(element) != -1
 }
 
-func (l *List[T]) indexOf(element T) int {
+/*
+Returns true if the given element exists in the List. Returns false otherwise.
+Returns an error wrapping errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements Lister.TryContains
+*/
+func (l *List[T]) TryContains(element T) (bool, error) {
+	index, err := l.indexOf(element)
+	return index != -1, err
+}
+
+func (l *List[T]) indexOf(element T) (int, error) {
 	if l.equals == nil {
-		panic("Cannot compute equality of elements since equality comparer is not set")
+		return -1, errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		)
 	}
 
-	for i, v := range l.container {
+	for i, v := range l.container[:l.size] {
 		if l.equals(&v, &element) {
-			return i
+			return i, nil
 		}
 	}
 
-	return -1
+	return -1, nil
 }
 
 /*
@@ -311,8 +461,25 @@ The returned sub list is a new, copied list of the current List.
 Implements Lister.SubList
 */
 func (l *List[T]) SubList(start int, end int) list.Lister[T] {
-	if !l.isValidIndex(start) || end > len(l.container) || start >= end {
-		panic("List.SubList Cannot create a sub list because invalid range was given")
+	subList, err := l.TrySubList(start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return subList
+}
+
+/*
+Returns a sub list of the current List from start index (inclusive) to end index (exclusive).
+Returns an error wrapping errors.ErrInvalidRange if the given range is invalid.
+Implements Lister.TrySubList
+*/
+func (l *List[T]) TrySubList(start int, end int) (list.Lister[T], error) {
+	if !l.isValidIndex(start) || end > l.size || start >= end {
+		return nil, errors.Newf(
+			errors.ErrInvalidRange,
+			"List.SubList Cannot create a sub list because invalid range was given",
+		)
 	}
 
 	newSize := end - start
@@ -323,7 +490,49 @@ func (l *List[T]) SubList(start int, end int) list.Lister[T] {
 		container: newContainer,
 		size:      newSize,
 		cap:       newSize,
+	}, nil
+}
+
+/*
+Returns true if the given list has the same size as the List and equal elements in the same order.
+Equality is determined by the equality comparer of the List. Panics if the equality comparer is not set.
+Implements Lister.Equals
+*/
+func (l *List[T]) Equals(other list.ReadOnlyLister[T]) bool {
+	return l.EqualsInOrder(other)
+}
+
+/*
+Returns true if the given collection has the same size as the List and its ForEach method visits elements
+equal to the elements of the List in the same order. Equality is determined by the equality comparer of the
+List. Panics if the equality comparer is not set
+*/
+func (l *List[T]) EqualsInOrder(other generic.Traversable[T]) bool {
+	if l.equals == nil {
+		panic(errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		))
 	}
+
+	return generic.SequenceEqual[T](l, other, l.equals)
+}
+
+/*
+Returns a hash code which depends on the elements of the List and their order. Elements are hashed with the
+given function, which must return equal hashes for elements the equality comparer considers equal.
+Implements Lister.HashCode
+*/
+func (l *List[T]) HashCode(hash func(*T) uint64) uint64 {
+	return generic.OrderedHashCode[T](l, hash)
+}
+
+/*
+Copies the elements and the equality comparer of the List into an immutable FrozenList, which can be shared
+across goroutines. Later changes to the List are not reflected in the FrozenList
+*/
+func (l *List[T]) Freeze() list.FrozenList[T] {
+	return list.NewFrozenList(l.equals, l.container[:l.size]...)
 }
 
 func (l *List[T]) isValidIndex(index int) bool {
@@ -344,7 +553,7 @@ Loops through the List and executes the given "do" function on each element.
 Implements Seter.ForEach and Collectioner.ForEach
 */
 func (l *List[T]) ForEach(do func(*T)) {
-	for _, v := range l.container {
-		do(&v)
+	for i := 0; i < l.size; i++ {
+		do(&l.container[i])
 	}
 }
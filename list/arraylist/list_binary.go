@@ -0,0 +1,48 @@
+package arraylist
+
+import "github.com/golanglibs/gocollections/codec"
+
+/*
+Encodes the List into a binary payload made of a versioned header (collection kind and element count)
+followed by the gob encoding of its elements in order.
+Implements encoding.BinaryMarshaler
+*/
+func (l List[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalElements(codec.KindArrayList, l.container[:l.size])
+}
+
+/*
+Decodes a payload produced by List.MarshalBinary and replaces the elements of the List with the decoded
+elements. Returns an error if the payload is corrupted or was produced by a different kind of collection.
+The equality comparer of the List is left untouched.
+Implements encoding.BinaryUnmarshaler
+*/
+func (l *List[T]) UnmarshalBinary(data []byte) error {
+	elements, err := codec.UnmarshalElements[T](codec.KindArrayList, data)
+	if err != nil {
+		return err
+	}
+
+	size := len(elements)
+	l.container = elements
+	l.size = size
+	l.cap = size
+
+	return nil
+}
+
+/*
+Encodes the List in the same format as List.MarshalBinary.
+Implements gob.GobEncoder
+*/
+func (l List[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+/*
+Decodes a payload produced by List.GobEncode.
+Implements gob.GobDecoder
+*/
+func (l *List[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
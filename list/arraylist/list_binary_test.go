@@ -0,0 +1,64 @@
+package arraylist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/codec"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_BinaryRoundTripShouldPreserveElementsInOrder(t *testing.T) {
+	original := New(10, 16, 14)
+	original.RemoveBack()
+
+	data, err := original.MarshalBinary()
+	goassert.Nil(t, err)
+
+	decoded := New[int]()
+	err = decoded.UnmarshalBinary(data)
+
+	goassert.Nil(t, err)
+	goassert.DeepEqual(t, []int{10, 16}, decoded.container)
+	goassert.True(t, decoded.Contains(16))
+}
+
+func Test_UnmarshalBinaryShouldReturnError_AndKeepElements_GivenCorruptedPayload(t *testing.T) {
+	data, _ := NewOfAny(testhelpers.MockStruct{Prop: 10}).MarshalBinary()
+	list := NewOfAny(testhelpers.MockStruct{Prop: 3})
+
+	err := list.UnmarshalBinary(data[:len(data)-1])
+
+	goassert.True(t, errors.Is(err, codec.ErrCorruptedPayload))
+	goassert.Equal(t, 3, list.At(0).Prop)
+}
+
+func Test_UnmarshalBinaryShouldReturnErrKindMismatch_GivenPayloadOfOtherCollection(t *testing.T) {
+	data, _ := codec.MarshalElements(codec.KindHashSet, []int{10})
+	list := New[int]()
+
+	err := list.UnmarshalBinary(data)
+
+	goassert.True(t, errors.Is(err, codec.ErrKindMismatch))
+}
+
+func Test_GobShouldEncodeAndDecodeList_AsStructField(t *testing.T) {
+	type snapshot struct {
+		Name  string
+		Items List[int]
+	}
+	original := snapshot{Name: "items", Items: New(10, 16, 14)}
+
+	var buffer bytes.Buffer
+	goassert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded snapshot
+	goassert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+
+	goassert.Equal(t, "items", decoded.Name)
+	goassert.DeepEqual(t, []int{10, 16, 14}, decoded.Items.container)
+	goassert.Nil(t, decoded.Items.equals)
+}
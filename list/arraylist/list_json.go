@@ -0,0 +1,37 @@
+package arraylist
+
+import "encoding/json"
+
+/*
+Encodes the List as a JSON array of its elements in order. An empty List is encoded as an empty array.
+Implements json.Marshaler
+*/
+func (l List[T]) MarshalJSON() ([]byte, error) {
+	elements := l.container[:l.size]
+	if elements == nil {
+		elements = []T{}
+	}
+
+	return json.Marshal(elements)
+}
+
+/*
+Decodes the given JSON array and replaces the elements of the List with the decoded elements in order.
+The equality comparer of the List is left untouched, so decoding into a List created with New keeps the
+default equality comparer while decoding into a zero value List or a List created with NewOfAny leaves it nil
+until Lister.SetEqualityComparer is called.
+Implements json.Unmarshaler
+*/
+func (l *List[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	size := len(elements)
+	l.container = elements
+	l.size = size
+	l.cap = size
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package arraylist
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_MarshalJSONShouldEncodeElementsInOrder(t *testing.T) {
+	list := New(10, 16, 14)
+
+	data, err := json.Marshal(list)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[10,16,14]", string(data))
+}
+
+func Test_MarshalJSONShouldEncodeEmptyArray_GivenEmptyList(t *testing.T) {
+	list := New[int]()
+
+	data, err := json.Marshal(list)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[]", string(data))
+}
+
+func Test_MarshalJSONShouldNotEncodeRemovedElements(t *testing.T) {
+	list := New(10, 16, 14)
+	list.RemoveBack()
+
+	data, err := json.Marshal(&list)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[10,16]", string(data))
+}
+
+func Test_UnmarshalJSONShouldReplaceElements_AndKeepDefaultEquals(t *testing.T) {
+	list := New(3)
+
+	err := json.Unmarshal([]byte("[10,16,14]"), &list)
+
+	goassert.Nil(t, err)
+	goassert.DeepEqual(t, []int{10, 16, 14}, list.container)
+	goassert.Equal(t, 3, list.Size())
+	goassert.True(t, list.Contains(16))
+}
+
+func Test_UnmarshalJSONShouldReturnError_GivenInvalidJSON(t *testing.T) {
+	list := New[int]()
+
+	err := json.Unmarshal([]byte(`{"a":1}`), &list)
+
+	goassert.NotNil(t, err)
+}
+
+func Test_JSONRoundTripShouldPreserveElements_ButNotEqualityComparer_GivenNewOfAny(t *testing.T) {
+	original := NewOfAny(testhelpers.MockStruct{Prop: 10}, testhelpers.MockStruct{Prop: 16})
+	original.SetEqualityComparer(func(a *testhelpers.MockStruct, b *testhelpers.MockStruct) bool {
+		return a.Prop == b.Prop
+	})
+
+	data, err := json.Marshal(original)
+	goassert.Nil(t, err)
+
+	var decoded List[testhelpers.MockStruct]
+	err = json.Unmarshal(data, &decoded)
+
+	goassert.Nil(t, err)
+	goassert.DeepEqual(t, original.container, decoded.container)
+	goassert.Nil(t, decoded.equals)
+	testhelpers.PanicWithErrorIs(t, errors.ErrNoEqualityComparer, missingEqualityComparerError, func() {
+		decoded.Contains(testhelpers.MockStruct{Prop: 10})
+	})
+}
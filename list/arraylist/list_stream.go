@@ -0,0 +1,36 @@
+package arraylist
+
+import (
+	"io"
+
+	"github.com/golanglibs/gocollections/codec"
+)
+
+/*
+Streams the List to the given writer without building the whole payload in memory. Writes a versioned header
+(collection kind and element count) followed by one length-prefixed frame per element in order, where each
+frame is produced by the given element encoder. Returns the number of bytes written
+*/
+func (l *List[T]) EncodeTo(w io.Writer, encodeElement codec.ElementEncoder[T]) (int64, error) {
+	forEach := func(do func(*T)) {
+		for i := 0; i < l.size; i++ {
+			do(&l.container[i])
+		}
+	}
+
+	return codec.WriteStream(w, codec.KindArrayList, l.size, forEach, encodeElement)
+}
+
+/*
+Reads a stream written by List.EncodeTo from the given reader and replaces the elements of the List with the
+elements decoded by the given element decoder. Elements are added as they are decoded, so if an error is
+returned the List holds the elements decoded before the error. The equality comparer is left untouched.
+Returns the number of bytes read
+*/
+func (l *List[T]) DecodeFrom(r io.Reader, decodeElement codec.ElementDecoder[T]) (int64, error) {
+	l.Clear()
+
+	return codec.ReadStream(r, codec.KindArrayList, decodeElement, func(element T) {
+		l.Add(element)
+	})
+}
@@ -0,0 +1,40 @@
+package arraylist
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/codec"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_StreamRoundTripShouldPreserveElementsInOrder(t *testing.T) {
+	original := New(10, 16, 14, 7)
+	original.RemoveBack()
+	var buffer bytes.Buffer
+
+	written, err := original.EncodeTo(&buffer, testhelpers.EncodeInt)
+	goassert.Nil(t, err)
+
+	decoded := New(3, 5, 9, 11)
+	read, err := decoded.DecodeFrom(&buffer, testhelpers.DecodeInt)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, written, read)
+	goassert.Equal(t, 3, decoded.Size())
+	goassert.DeepEqual(t, []int{10, 16, 14}, decoded.container[:decoded.size])
+	goassert.True(t, decoded.Contains(16))
+}
+
+func Test_DecodeFromShouldReturnErrKindMismatch_GivenStreamOfOtherCollection(t *testing.T) {
+	var buffer bytes.Buffer
+	other := New(10)
+	codec.WriteStream(&buffer, codec.KindArrayStack, 1, other.ForEach, testhelpers.EncodeInt)
+
+	list := New[int]()
+	_, err := list.DecodeFrom(&buffer, testhelpers.DecodeInt)
+
+	goassert.True(t, errors.Is(err, codec.ErrKindMismatch))
+}
@@ -5,8 +5,11 @@ import (
 	"testing"
 
 	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/list"
+	"github.com/golanglibs/gocollections/list/doublylinkedlist"
 	"github.com/golanglibs/gocollections/testhelpers"
 )
 
@@ -86,7 +89,7 @@ func Test_AtShouldPanic_GivenOutOfRangeIndex(t *testing.T) {
 	expectedError :=
 		fmt.Sprintf("List.At could not retrieve element because given index %d is out of range", outOfRangeIndex)
 
-	goassert.PanicWithError(t, expectedError, func() { list.At(outOfRangeIndex) })
+	testhelpers.PanicWithErrorIs(t, errors.ErrIndexOutOfRange, expectedError, func() { list.At(outOfRangeIndex) })
 }
 
 func Test_SetShouldSetGivenValue_AtGivenIndex(t *testing.T) {
@@ -103,7 +106,7 @@ func Test_SetShouldPanic_GivenOutOfRangeIndex(t *testing.T) {
 	expectedError :=
 		fmt.Sprintf("List.Set could not set given value because given index %d is out of range", outOfRangeIndex)
 
-	goassert.PanicWithError(t, expectedError, func() { list.Set(outOfRangeIndex, 8) })
+	testhelpers.PanicWithErrorIs(t, errors.ErrIndexOutOfRange, expectedError, func() { list.Set(outOfRangeIndex, 8) })
 }
 
 func Test_SizeShouldReturnTheCorrectLengthOfList(t *testing.T) {
@@ -129,7 +132,7 @@ func Test_FrontShouldReturnRefToFirstElement(t *testing.T) {
 
 func Test_FrontShouldPanic_GivenEmptyList(t *testing.T) {
 	list := New[int]()
-	goassert.PanicWithError(t, "ArrayList.Front failed because the list is empty", func() {
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "ArrayList.Front failed because the list is empty", func() {
 		list.Front()
 	})
 }
@@ -141,7 +144,7 @@ func Test_BackshouldReturnRefToLastElement(t *testing.T) {
 
 func Test_BackShouldPanic_GivenEmptyList(t *testing.T) {
 	list := New[int]()
-	goassert.PanicWithError(t, "ArrayList.Back failed because the list is empty", func() {
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "ArrayList.Back failed because the list is empty", func() {
 		list.Back()
 	})
 }
@@ -168,7 +171,7 @@ func Test_RemoveBackShouldRemoveLastElement(t *testing.T) {
 
 func Test_RemoveShouldPanic_GivenEmptyList(t *testing.T) {
 	list := New[int]()
-	goassert.PanicWithError(t, "ArrayList.RemoveBack failed because the list is empty", func() {
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "ArrayList.RemoveBack failed because the list is empty", func() {
 		list.RemoveBack()
 	})
 }
@@ -209,6 +212,17 @@ func Test_InsertShouldInsertGivenElementAtEndOfList_And_ReturnTrue_GivenMaxIndex
 	goassert.DeepEqual(t, expectedElements, list.container)
 }
 
+func Test_InsertShouldShiftOnlyCurrentElements_WhenCapacityIsAvailable(t *testing.T) {
+	list := New(10, 5, 16)
+	list.RemoveBack()
+
+	list.Insert(0, 7)
+	list.Add(3)
+
+	goassert.Equal(t, 4, list.Size())
+	goassert.DeepEqual(t, []int{7, 10, 5, 3}, list.container[:list.size])
+}
+
 func Test_AddToFrontShouldInsertGivenElementToFrontOfList(t *testing.T) {
 	list := New(16)
 	list.AddToFront(14)
@@ -229,13 +243,9 @@ func Test_RemoveFrontShouldRemoveFirstElementOfList(t *testing.T) {
 
 func Test_RemoveFrontShouldPanic_GivenEmptyList(t *testing.T) {
 	list := New[int]()
-	goassert.PanicWithError(
-		t,
-		"ArrayList.RemoveAt cannot remove element at index 0 because it is out of range",
-		func() {
-			list.RemoveFront()
-		},
-	)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "ArrayList.RemoveFront failed because the list is empty", func() {
+		list.RemoveFront()
+	})
 }
 
 func Test_RemoveShouldRemoveGivenElement_And_ReturnTrue_IfGivenElementExistsAtBeginningOfList(t *testing.T) {
@@ -281,7 +291,7 @@ func Test_RemoveShouldRemoveGivenElement_And_ReturnFalse_IfGivenElementDoesNotEx
 func Test_RemoveShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
 	list := NewOfAny(testhelpers.NewMockStruct(10))
 
-	goassert.PanicWithError(t, missingEqualityComparerError, func() {
+	testhelpers.PanicWithErrorIs(t, errors.ErrNoEqualityComparer, missingEqualityComparerError, func() {
 		list.Remove(testhelpers.NewMockStruct(10))
 	})
 }
@@ -316,10 +326,22 @@ func Test_RemoveAtShouldRemoveElementAtGivenIndex_GivenEndIndex(t *testing.T) {
 	goassert.Equal(t, 2, list.size)
 }
 
+func Test_AddShouldAppendElement_AfterElementWasRemoved(t *testing.T) {
+	list := New(10, 5, 16)
+	list.RemoveAt(1)
+	list.Remove(16)
+
+	list.Add(7)
+
+	goassert.Equal(t, 2, list.Size())
+	goassert.DeepEqual(t, []int{10, 7}, list.container[:list.size])
+}
+
 func Test_RemoveAtShouldPanicGivenOutOfRangeIndex(t *testing.T) {
 	list := New(10, 16, 5)
-	goassert.PanicWithError(
+	testhelpers.PanicWithErrorIs(
 		t,
+		errors.ErrIndexOutOfRange,
 		"ArrayList.RemoveAt cannot remove element at index 10 because it is out of range",
 		func() {
 			list.RemoveAt(10)
@@ -359,6 +381,32 @@ func Test_ContainsShouldReturnFalse_IfGivenElementIsNotFound(t *testing.T) {
 	goassert.False(t, contains)
 }
 
+func Test_ContainsAndRemoveShouldIgnoreRemovedElements_AfterRemoveBack(t *testing.T) {
+	list := New(10, 16, 5)
+	list.RemoveBack()
+
+	contains, err := list.TryContains(5)
+	removed := list.Remove(5)
+
+	goassert.Nil(t, err)
+	goassert.False(t, contains)
+	goassert.False(t, removed)
+	goassert.Equal(t, 2, list.Size())
+}
+
+func Test_ContainsAndRemoveShouldIgnoreRemovedElements_AfterClear(t *testing.T) {
+	list := New(10, 16, 5)
+	list.Clear()
+
+	contains, err := list.TryContains(10)
+	removed := list.Remove(10)
+
+	goassert.Nil(t, err)
+	goassert.False(t, contains)
+	goassert.False(t, removed)
+	goassert.Equal(t, 0, list.Size())
+}
+
 func Test_SubListShouldReturnNewCopiedSubList_GivenValidRange(t *testing.T) {
 	list := New(16, 10, 5, 16, 10, 5)
 
@@ -398,7 +446,7 @@ func Test_SubListShouldPanic_GivenInvalidRange(t *testing.T) {
 	}
 
 	for _, c := range testCases {
-		goassert.PanicWithError(t, expectedError, func() { list.SubList(c.start, c.end) })
+		testhelpers.PanicWithErrorIs(t, errors.ErrInvalidRange, expectedError, func() { list.SubList(c.start, c.end) })
 	}
 }
 
@@ -421,6 +469,22 @@ func Test_ForEachShouldIterateThroughTheList_And_ExecuteGivenFunctionOnEachEleme
 	goassert.Equal(t, 31, sum)
 }
 
+func Test_ForEachShouldSkipRemovedElements_AfterRemoveBackOrClear(t *testing.T) {
+	list := New(10, 16, 5)
+	list.RemoveBack()
+
+	visited := []int{}
+	list.ForEach(func(element *int) {
+		visited = append(visited, *element)
+	})
+	goassert.DeepEqual(t, []int{10, 16}, visited)
+
+	list.Clear()
+	list.ForEach(func(element *int) {
+		t.Errorf("Expected no element to be visited but visited %d", *element)
+	})
+}
+
 func Test_ArrayListShouldImplementLister(t *testing.T) {
 	list := New[int]()
 	testLister[int](&list)
@@ -430,3 +494,210 @@ func Test_ArrayListShouldImplementCollectioner(t *testing.T) {
 	list := New[int]()
 	testCollectioner[int](&list)
 }
+
+func Test_TryAtShouldReturnElementAtGivenIndex_GivenValidIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	element, err := list.TryAt(1)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 16, *element)
+}
+
+func Test_TryAtShouldReturnErrIndexOutOfRange_GivenOutOfRangeIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	element, err := list.TryAt(3)
+
+	testhelpers.ErrorIs(t, errors.ErrIndexOutOfRange, err)
+	goassert.Nil(t, element)
+}
+
+func Test_TrySetShouldReturnErrIndexOutOfRange_GivenOutOfRangeIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	err := list.TrySet(-1, 8)
+
+	testhelpers.ErrorIs(t, errors.ErrIndexOutOfRange, err)
+	goassert.Equal(t, 10, *list.At(0))
+}
+
+func Test_TryFrontAndTryBackShouldReturnErrEmpty_GivenEmptyList(t *testing.T) {
+	list := New[int]()
+
+	front, frontErr := list.TryFront()
+	back, backErr := list.TryBack()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, frontErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, backErr)
+	goassert.Nil(t, front)
+	goassert.Nil(t, back)
+}
+
+func Test_TryRemoveBackShouldReturnErrEmpty_GivenEmptyList(t *testing.T) {
+	list := New[int]()
+
+	err := list.TryRemoveBack()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+}
+
+func Test_TryRemoveFrontShouldReturnErrEmpty_GivenEmptyList(t *testing.T) {
+	list := New[int]()
+
+	err := list.TryRemoveFront()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+}
+
+func Test_TryRemoveAtShouldRemoveElement_GivenValidIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	err := list.TryRemoveAt(1)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 2, list.Size())
+	goassert.Equal(t, 5, *list.At(1))
+}
+
+func Test_TryRemoveAtShouldReturnErrIndexOutOfRange_GivenOutOfRangeIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	err := list.TryRemoveAt(3)
+
+	testhelpers.ErrorIs(t, errors.ErrIndexOutOfRange, err)
+	goassert.Equal(t, 3, list.Size())
+}
+
+func Test_TryContainsAndTryIndexOfShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	list := NewOfAny(testhelpers.NewMockStruct(10))
+
+	contains, containsErr := list.TryContains(testhelpers.NewMockStruct(10))
+	index, indexOfErr := list.TryIndexOf(testhelpers.NewMockStruct(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, containsErr)
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, indexOfErr)
+	goassert.False(t, contains)
+	goassert.Equal(t, -1, index)
+}
+
+func Test_TryRemoveShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	list := NewOfAny(testhelpers.NewMockStruct(10))
+
+	removed, err := list.TryRemove(testhelpers.NewMockStruct(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	goassert.False(t, removed)
+	goassert.Equal(t, 1, list.Size())
+}
+
+func Test_TrySubListShouldReturnErrInvalidRange_GivenInvalidRange(t *testing.T) {
+	list := New(10, 16, 5)
+
+	subList, err := list.TrySubList(2, 1)
+
+	testhelpers.ErrorIs(t, errors.ErrInvalidRange, err)
+	goassert.Nil(t, subList)
+}
+
+func Test_TrySubListShouldReturnErrInvalidRange_GivenEndPastRemovedElements(t *testing.T) {
+	list := New(10, 16, 5)
+	list.RemoveBack()
+
+	subList, err := list.TrySubList(0, 3)
+
+	testhelpers.ErrorIs(t, errors.ErrInvalidRange, err)
+	goassert.Nil(t, subList)
+}
+
+func Test_EqualsShouldReturnTrue_GivenListWithEqualElementsInSameOrder(t *testing.T) {
+	list := New(10, 16, 5)
+	otherList := doublylinkedlist.New(10, 16, 5)
+
+	goassert.True(t, list.Equals(&otherList))
+}
+
+func Test_EqualsShouldReturnFalse_GivenListWithDifferentOrderOrSize(t *testing.T) {
+	list := New(10, 16, 5)
+	reordered := New(10, 5, 16)
+	shorter := New(10, 16)
+
+	goassert.False(t, list.Equals(&reordered))
+	goassert.False(t, list.Equals(&shorter))
+}
+
+func Test_EqualsShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
+	list := NewOfAny(testhelpers.NewMockStruct(10))
+	otherList := NewOfAny(testhelpers.NewMockStruct(10))
+
+	testhelpers.PanicWithErrorIs(t, errors.ErrNoEqualityComparer, missingEqualityComparerError, func() {
+		list.Equals(&otherList)
+	})
+}
+
+func Test_HashCodeShouldBeEqual_GivenEqualLists(t *testing.T) {
+	list := New(10, 16, 5)
+	otherList := doublylinkedlist.New(10, 16, 5)
+	reordered := New(5, 16, 10)
+
+	hash := comparer.DefaultHash[int]
+	goassert.Equal(t, list.HashCode(hash), otherList.HashCode(hash))
+	goassert.NotEqual(t, list.HashCode(hash), reordered.HashCode(hash))
+}
+
+func Test_FreezeShouldCopyElements_And_NotReflectLaterChanges(t *testing.T) {
+	list := New(10, 16, 5)
+
+	frozen := list.Freeze()
+	list.Set(0, 1)
+	list.Add(14)
+
+	goassert.Equal(t, 3, frozen.Size())
+	goassert.Equal(t, 10, *frozen.At(0))
+	goassert.True(t, frozen.Contains(5))
+}
+
+func Test_UnmodifiableShouldReflectChanges_ButPanicOnModification(t *testing.T) {
+	l := New(10, 16, 5)
+	unmodifiable := list.Unmodifiable[int](&l)
+
+	l.Add(14)
+
+	goassert.Equal(t, 4, unmodifiable.Size())
+	goassert.Equal(t, 14, *unmodifiable.Back())
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"UnmodifiableList.Add failed because the list is unmodifiable",
+		func() { unmodifiable.Add(1) },
+	)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"UnmodifiableList.Clear failed because the list is unmodifiable",
+		func() { unmodifiable.Clear() },
+	)
+	testhelpers.ErrorIs(t, errors.ErrUnmodifiable, unmodifiable.TrySet(0, 1))
+	testhelpers.ErrorIs(t, errors.ErrUnmodifiable, unmodifiable.TryRemoveAt(0))
+	goassert.Equal(t, 4, l.Size())
+}
+
+func Test_UnmodifiableShouldNotExposeReferencesToElements(t *testing.T) {
+	l := New(10, 16, 5)
+	unmodifiable := list.Unmodifiable[int](&l)
+
+	*unmodifiable.At(0) = 1
+	unmodifiable.ForEach(func(element *int) {
+		*element = 1
+	})
+
+	goassert.Equal(t, 10, *l.At(0))
+	goassert.True(t, unmodifiable.Equals(&l))
+}
+
+func Test_UnmodifiableListShouldImplementLister(t *testing.T) {
+	l := New[int]()
+	unmodifiable := list.Unmodifiable[int](&l)
+
+	testLister[int](&unmodifiable)
+}
@@ -0,0 +1,32 @@
+package arraylist
+
+import (
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Checks the internal state of the List: the size must not be negative or exceed the capacity, and the capacity
+must match the length of the internal slice. Returns an error wrapping errors.ErrInvariantViolated describing the
+first violation found, or nil if the List is consistent. Meant to be used from tests and debug builds
+*/
+func (l *List[T]) Validate() error {
+	if l.size < 0 || l.size > l.cap {
+		return errors.Newf(
+			errors.ErrInvariantViolated,
+			"ArrayList.Validate found size %d outside of the range [0, %d] allowed by its capacity",
+			l.size,
+			l.cap,
+		)
+	}
+
+	if l.cap != len(l.container) {
+		return errors.Newf(
+			errors.ErrInvariantViolated,
+			"ArrayList.Validate found capacity %d which does not match the length %d of the internal slice",
+			l.cap,
+			len(l.container),
+		)
+	}
+
+	return nil
+}
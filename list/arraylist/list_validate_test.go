@@ -0,0 +1,36 @@
+package arraylist
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_ValidateShouldReturnNil_GivenConsistentList(t *testing.T) {
+	var zero List[int]
+	l := New(1, 2, 3)
+	l.Add(4)
+	l.RemoveAt(0)
+	l.Clear()
+	l.Add(5)
+
+	goassert.Nil(t, zero.Validate())
+	goassert.Nil(t, l.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenSizeGreaterThanCapacity(t *testing.T) {
+	l := New(1, 2, 3)
+	l.size = 4
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, l.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenCapacityNotMatchingInternalSlice(t *testing.T) {
+	l := New(1, 2, 3)
+	l.container = l.container[:2]
+	l.size = 2
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, l.Validate())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/list"
 )
@@ -139,37 +140,79 @@ This operation has time complexity of O(n) where n is the number of elements in
 Implements lister.At
 */
 func (dll *DoublyLinkedList[T]) At(index int) *T {
+	element, err := dll.TryAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Retrieves and returns a reference to the element at the given index. Returns an error wrapping
+errors.ErrIndexOutOfRange if the given index is out of range. Time complexity is O(n).
+Implements Lister.TryAt
+*/
+func (dll *DoublyLinkedList[T]) TryAt(index int) (*T, error) {
 	if !dll.isValidIndex(index) {
-		err := fmt.Sprintf(
+		return nil, errors.Newf(
+			errors.ErrIndexOutOfRange,
 			"DoublyLinkedList.At could not retrieve element because given index %d is out of range",
 			index,
 		)
-		panic(err)
 	}
 
-	return &dll.findNodeAtIndex(index).Value
+	return &dll.findNodeAtIndex(index).Value, nil
 }
 
 /*
 Returns the reference to the value of the head of the DoublyLinkedList. Panics if the list is empty.
 */
 func (dll *DoublyLinkedList[T]) Front() *T {
+	element, err := dll.TryFront()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns the reference to the value of the head of the DoublyLinkedList. Returns an error wrapping
+errors.ErrEmpty if the list is empty.
+Implements Lister.TryFront
+*/
+func (dll *DoublyLinkedList[T]) TryFront() (*T, error) {
 	if dll.size == 0 {
-		panic("DoublyLinkedList.Front failed because the list is empty")
+		return nil, errors.Newf(errors.ErrEmpty, "DoublyLinkedList.Front failed because the list is empty")
 	}
 
-	return &dll.head.Next.Value
+	return &dll.head.Next.Value, nil
 }
 
 /*
 Returns value of the tail of the DoublyLinkedList. Panics if the list is empty.
 */
 func (dll *DoublyLinkedList[T]) Back() *T {
+	element, err := dll.TryBack()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns value of the tail of the DoublyLinkedList. Returns an error wrapping errors.ErrEmpty if the list is
+empty.
+Implements Lister.TryBack
+*/
+func (dll *DoublyLinkedList[T]) TryBack() (*T, error) {
 	if dll.size == 0 {
-		panic("DoublyLinkedList.Back failed because the list is empty")
+		return nil, errors.Newf(errors.ErrEmpty, "DoublyLinkedList.Back failed because the list is empty")
 	}
 
-	return &dll.tail.Prev.Value
+	return &dll.tail.Prev.Value, nil
 }
 
 /*
@@ -177,16 +220,29 @@ Sets the given value at the given index. Panics if the given index is out of ran
 Implements lister.Set
 */
 func (dll *DoublyLinkedList[T]) Set(index int, value T) {
+	if err := dll.TrySet(index, value); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Sets the given value at the given index. Returns an error wrapping errors.ErrIndexOutOfRange if the given index
+is out of range.
+Implements Lister.TrySet
+*/
+func (dll *DoublyLinkedList[T]) TrySet(index int, value T) error {
 	if !dll.isValidIndex(index) {
-		err := fmt.Sprintf(
+		return errors.Newf(
+			errors.ErrIndexOutOfRange,
 			"DoublyLinkedList.Set could not set given value because given index %d is out of range",
 			index,
 		)
-		panic(err)
 	}
 
 	node := dll.findNodeAtIndex(index)
 	node.Value = value
+
+	return nil
 }
 
 /*
@@ -225,11 +281,25 @@ func (dll *DoublyLinkedList[T]) Add(element T) bool {
 Removes the tail of the DoublyLinkedList
 */
 func (dll *DoublyLinkedList[T]) RemoveBack() {
+	if err := dll.TryRemoveBack(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the tail of the DoublyLinkedList. Returns an error wrapping errors.ErrEmpty if the list is empty.
+Implements Lister.TryRemoveBack
+*/
+func (dll *DoublyLinkedList[T]) TryRemoveBack() error {
 	if dll.size == 0 {
-		panic("DoublyLinkedList.RemoveBack cannot remove tail because list is empty")
+		return errors.Newf(
+			errors.ErrEmpty,
+			"DoublyLinkedList.RemoveBack cannot remove tail because list is empty",
+		)
 	}
 
 	dll.removeNode(dll.tail.Prev)
+	return nil
 }
 
 /*
@@ -275,11 +345,25 @@ func (dll *DoublyLinkedList[T]) AddToFront(element T) {
 Removes the head of the DoublyLinkedList
 */
 func (dll *DoublyLinkedList[T]) RemoveFront() {
+	if err := dll.TryRemoveFront(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the head of the DoublyLinkedList. Returns an error wrapping errors.ErrEmpty if the list is empty.
+Implements Lister.TryRemoveFront
+*/
+func (dll *DoublyLinkedList[T]) TryRemoveFront() error {
 	if dll.size == 0 {
-		panic("DoublyLinkedList.RemoveFront cannot remove head because list is empty")
+		return errors.Newf(
+			errors.ErrEmpty,
+			"DoublyLinkedList.RemoveFront cannot remove head because list is empty",
+		)
 	}
 
 	dll.removeNode(dll.head.Next)
+	return nil
 }
 
 /*
@@ -290,14 +374,29 @@ elements) of manually (through Lister.SetEqualityComparer)
 Implements Lister.Remove and Collectioner.Remove
 */
 func (dll *DoublyLinkedList[T]) Remove(element T) bool {
-	nodeIndex, nodeToRemove := dll.findNode(element)
-	if nodeIndex == -1 {
-		return false
+	removed, err := dll.TryRemove(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return removed
+}
+
+/*
+Removes the first occurrence of the element if found from the DoublyLinkedList and returns true.
+If the element is not found, returns false. Returns an error wrapping errors.ErrNoEqualityComparer if the
+equality comparer is not set.
+Implements Lister.TryRemove
+*/
+func (dll *DoublyLinkedList[T]) TryRemove(element T) (bool, error) {
+	nodeIndex, nodeToRemove, err := dll.findNode(element)
+	if err != nil || nodeIndex == -1 {
+		return false, err
 	}
 
 	dll.removeNode(nodeToRemove)
 
-	return true
+	return true, nil
 }
 
 /*
@@ -307,16 +406,29 @@ either the head or tail depending on whether the index is in the first or last h
 Implements Lister.RemoveAt
 */
 func (dll *DoublyLinkedList[T]) RemoveAt(index int) {
+	if err := dll.TryRemoveAt(index); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the element at the given index. Returns an error wrapping errors.ErrIndexOutOfRange if the given index
+is out of range.
+Implements Lister.TryRemoveAt
+*/
+func (dll *DoublyLinkedList[T]) TryRemoveAt(index int) error {
 	if !dll.isValidIndex(index) {
-		err := fmt.Sprintf(
+		return errors.Newf(
+			errors.ErrIndexOutOfRange,
 			"DoublyLinkedList.RemoveAt cannot remove element at index %d because it is out of range",
 			index,
 		)
-		panic(err)
 	}
 
 	nodeToRemove := dll.findNodeAtIndex(index)
 	dll.removeNode(nodeToRemove)
+
+	return nil
 }
 
 /*
@@ -370,11 +482,25 @@ elements) of manually (through Lister.SetEqualityComparer)
 Implements Lister.IndexOf
 */
 func (dll *DoublyLinkedList[T]) IndexOf(element T) int {
-	nodeIndex, _ := dll.findNode(element)
+	nodeIndex, err := dll.TryIndexOf(element)
+	if err != nil {
+		panic(err)
+	}
 
 	return nodeIndex
 }
 
+/*
+Returns the index of the first occurrence of the given value or -1 if the element is not found.
+Returns an error wrapping errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements Lister.TryIndexOf
+*/
+func (dll *DoublyLinkedList[T]) TryIndexOf(element T) (int, error) {
+	nodeIndex, _, err := dll.findNode(element)
+
+	return nodeIndex, err
+}
+
 /*
 Returns true if the given element exists in the DoublyLinkedList. Returns false otherwise.
 Equality is determined by the equality comparer set either automatically (through constructors for comparable
@@ -382,32 +508,53 @@ elements) or manually (through Lister.SetEqualityComparer).
 Implements Lister.Contains and Collectioner.Contains
 */
 func (dll *DoublyLinkedList[T]) Contains(element T) bool {
-	nodeIndex, _ := dll.findNode(element)
+	return dll.IndexOf(element) != -1
+}
 
-	return nodeIndex != -1
+/*
+Returns true if the given element exists in the DoublyLinkedList. Returns false otherwise.
+Returns an error wrapping errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements Lister.TryContains
+*/
+func (dll *DoublyLinkedList[T]) TryContains(element T) (bool, error) {
+	nodeIndex, err := dll.TryIndexOf(element)
+
+	return nodeIndex != -1, err
 }
 
-func (dll *DoublyLinkedList[T]) findNode(element T) (nodeIndex int, node *node[T]) {
+func (dll *DoublyLinkedList[T]) findNode(element T) (nodeIndex int, node *node[T], err error) {
 	if dll.equals == nil {
-		panic("Cannot compute equality of elements since equality comparer is not set")
+		return -1, nil, errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		)
+	}
+
+	if dll.size == 0 {
+		return -1, nil, nil
 	}
 
 	current := dll.head.Next
 	for i := 0; i < dll.size; i++ {
 		if dll.equals(&current.Value, &element) {
-			return i, current
+			return i, current, nil
 		}
 
 		current = current.Next
 	}
 
-	return -1, nil
+	return -1, nil, nil
 }
 
 /*
-Empties the current DoublyLinkedList. Implements Lister.Clear and Collectioner.Clear
+Empties the current DoublyLinkedList. A zero value DoublyLinkedList becomes usable after being cleared.
+Implements Lister.Clear and Collectioner.Clear
 */
 func (dll *DoublyLinkedList[T]) Clear() {
+	if dll.head == nil {
+		dll.head, dll.tail = initializeHeadAndTailFromSlice[T](nil)
+	}
+
 	dll.head.Next = dll.tail
 	dll.tail.Prev = dll.head
 	dll.size = 0
@@ -419,8 +566,25 @@ The return sub list is a new, copied list of the current DoublyLinkedList.
 Implements Lister.SubList
 */
 func (dll *DoublyLinkedList[T]) SubList(start int, end int) list.Lister[T] {
+	subList, err := dll.TrySubList(start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return subList
+}
+
+/*
+Returns a sub list of the current DoublyLinkedList from start index (inclusive) to end index (exclusive).
+Returns an error wrapping errors.ErrInvalidRange if the given range is invalid.
+Implements Lister.TrySubList
+*/
+func (dll *DoublyLinkedList[T]) TrySubList(start int, end int) (list.Lister[T], error) {
 	if !dll.isValidIndex(start) || end > dll.size || start >= end {
-		panic("DoublyLinkedList.SubList Cannot create a sub list because invalid range was given")
+		return nil, errors.Newf(
+			errors.ErrInvalidRange,
+			"DoublyLinkedList.SubList Cannot create a sub list because invalid range was given",
+		)
 	}
 
 	subListHead := newEmptyNode[T]()
@@ -448,7 +612,54 @@ func (dll *DoublyLinkedList[T]) SubList(start int, end int) list.Lister[T] {
 		tail:   subListTail,
 		equals: dll.equals,
 		size:   end - start,
+	}, nil
+}
+
+/*
+Returns true if the given list has the same size as the DoublyLinkedList and equal elements in the same order.
+Equality is determined by the equality comparer of the DoublyLinkedList. Panics if the equality comparer is not set.
+Implements Lister.Equals
+*/
+func (dll *DoublyLinkedList[T]) Equals(other list.ReadOnlyLister[T]) bool {
+	return dll.EqualsInOrder(other)
+}
+
+/*
+Returns true if the given collection has the same size as the DoublyLinkedList and its ForEach method visits elements
+equal to the elements of the DoublyLinkedList in the same order. Equality is determined by the equality comparer of the
+DoublyLinkedList. Panics if the equality comparer is not set
+*/
+func (dll *DoublyLinkedList[T]) EqualsInOrder(other generic.Traversable[T]) bool {
+	if dll.equals == nil {
+		panic(errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		))
 	}
+
+	return generic.SequenceEqual[T](dll, other, dll.equals)
+}
+
+/*
+Returns a hash code which depends on the elements of the DoublyLinkedList and their order. Elements are hashed with the
+given function, which must return equal hashes for elements the equality comparer considers equal.
+Implements Lister.HashCode
+*/
+func (dll *DoublyLinkedList[T]) HashCode(hash func(*T) uint64) uint64 {
+	return generic.OrderedHashCode[T](dll, hash)
+}
+
+/*
+Copies the elements and the equality comparer of the DoublyLinkedList into an immutable FrozenList, which can
+be shared across goroutines. Later changes to the DoublyLinkedList are not reflected in the FrozenList
+*/
+func (dll *DoublyLinkedList[T]) Freeze() list.FrozenList[T] {
+	elements := make([]T, 0, dll.size)
+	dll.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return list.NewFrozenList(dll.equals, elements...)
 }
 
 func (dll *DoublyLinkedList[T]) isValidIndex(index int) bool {
@@ -460,6 +671,10 @@ Loops through the DoublyLinkedList and executes the given "do" function on each
 Implements Lister.ForEach and Collectioner.ForEach
 */
 func (dll *DoublyLinkedList[T]) ForEach(do func(*T)) {
+	if dll.size == 0 {
+		return
+	}
+
 	current := dll.head.Next
 	for i := 0; i < dll.size; i++ {
 		do(&current.Value)
@@ -0,0 +1,51 @@
+package doublylinkedlist
+
+import "github.com/golanglibs/gocollections/codec"
+
+/*
+Encodes the DoublyLinkedList into a binary payload made of a versioned header (collection kind and element
+count) followed by the gob encoding of its elements from head to tail.
+Implements encoding.BinaryMarshaler
+*/
+func (dll DoublyLinkedList[T]) MarshalBinary() ([]byte, error) {
+	elements := make([]T, 0, dll.size)
+	dll.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return codec.MarshalElements(codec.KindDoublyLinkedList, elements)
+}
+
+/*
+Decodes a payload produced by DoublyLinkedList.MarshalBinary and replaces the elements of the
+DoublyLinkedList with the decoded elements. Returns an error if the payload is corrupted or was produced by a
+different kind of collection. The equality comparer of the DoublyLinkedList is left untouched.
+Implements encoding.BinaryUnmarshaler
+*/
+func (dll *DoublyLinkedList[T]) UnmarshalBinary(data []byte) error {
+	elements, err := codec.UnmarshalElements[T](codec.KindDoublyLinkedList, data)
+	if err != nil {
+		return err
+	}
+
+	dll.head, dll.tail = initializeHeadAndTailFromSlice(elements)
+	dll.size = len(elements)
+
+	return nil
+}
+
+/*
+Encodes the DoublyLinkedList in the same format as DoublyLinkedList.MarshalBinary.
+Implements gob.GobEncoder
+*/
+func (dll DoublyLinkedList[T]) GobEncode() ([]byte, error) {
+	return dll.MarshalBinary()
+}
+
+/*
+Decodes a payload produced by DoublyLinkedList.GobEncode.
+Implements gob.GobDecoder
+*/
+func (dll *DoublyLinkedList[T]) GobDecode(data []byte) error {
+	return dll.UnmarshalBinary(data)
+}
@@ -0,0 +1,48 @@
+package doublylinkedlist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/codec"
+)
+
+func Test_BinaryRoundTripShouldPreserveElementsInOrder(t *testing.T) {
+	original := New("a", "b", "c")
+
+	data, err := original.MarshalBinary()
+	goassert.Nil(t, err)
+
+	decoded := New[string]()
+	err = decoded.UnmarshalBinary(data)
+
+	goassert.Nil(t, err)
+	verifyDoublyLinkedList(t, []string{"a", "b", "c"}, &decoded)
+	goassert.True(t, decoded.Contains("b"))
+}
+
+func Test_UnmarshalBinaryShouldReturnErrKindMismatch_GivenPayloadOfArrayList(t *testing.T) {
+	data, _ := codec.MarshalElements(codec.KindArrayList, []int{10})
+	list := New(3)
+
+	err := list.UnmarshalBinary(data)
+
+	goassert.True(t, errors.Is(err, codec.ErrKindMismatch))
+	verifyDoublyLinkedList(t, []int{3}, &list)
+}
+
+func Test_GobShouldDecodeIntoZeroValueList(t *testing.T) {
+	original := New(10, 16, 14)
+
+	var buffer bytes.Buffer
+	goassert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded DoublyLinkedList[int]
+	goassert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+
+	verifyDoublyLinkedList(t, []int{10, 16, 14}, &decoded)
+	goassert.Equal(t, 3, decoded.Size())
+}
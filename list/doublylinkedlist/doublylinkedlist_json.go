@@ -0,0 +1,36 @@
+package doublylinkedlist
+
+import "encoding/json"
+
+/*
+Encodes the DoublyLinkedList as a JSON array of its elements from head to tail. An empty DoublyLinkedList is
+encoded as an empty array.
+Implements json.Marshaler
+*/
+func (dll DoublyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	elements := make([]T, 0, dll.size)
+	dll.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return json.Marshal(elements)
+}
+
+/*
+Decodes the given JSON array and replaces the elements of the DoublyLinkedList with the decoded elements in
+order. The equality comparer of the DoublyLinkedList is left untouched, so decoding into a DoublyLinkedList
+created with New keeps the default equality comparer while decoding into a zero value DoublyLinkedList or a
+DoublyLinkedList created with NewOfAny leaves it nil until Lister.SetEqualityComparer is called.
+Implements json.Unmarshaler
+*/
+func (dll *DoublyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	dll.head, dll.tail = initializeHeadAndTailFromSlice(elements)
+	dll.size = len(elements)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package doublylinkedlist
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_MarshalJSONShouldEncodeElementsFromHeadToTail(t *testing.T) {
+	list := New(10, 16, 14)
+
+	data, err := json.Marshal(list)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[10,16,14]", string(data))
+}
+
+func Test_MarshalJSONShouldEncodeEmptyArray_GivenZeroValueList(t *testing.T) {
+	var list DoublyLinkedList[int]
+
+	data, err := json.Marshal(list)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[]", string(data))
+}
+
+func Test_UnmarshalJSONShouldReplaceElements_AndKeepDefaultEquals(t *testing.T) {
+	list := New(3, 5)
+
+	err := json.Unmarshal([]byte("[10,16,14]"), &list)
+
+	goassert.Nil(t, err)
+	verifyDoublyLinkedList(t, []int{10, 16, 14}, &list)
+	goassert.Equal(t, 3, list.Size())
+	goassert.True(t, list.Contains(14))
+}
+
+func Test_UnmarshalJSONShouldReturnError_GivenInvalidJSON(t *testing.T) {
+	list := New[int]()
+
+	err := json.Unmarshal([]byte(`"abc"`), &list)
+
+	goassert.NotNil(t, err)
+}
+
+func Test_JSONRoundTripShouldPreserveElements_ButNotEqualityComparer_GivenNewOfAny(t *testing.T) {
+	original := NewOfAny(testhelpers.MockStruct{Prop: 10}, testhelpers.MockStruct{Prop: 16})
+	original.SetEqualityComparer(func(a *testhelpers.MockStruct, b *testhelpers.MockStruct) bool {
+		return a.Prop == b.Prop
+	})
+
+	data, err := json.Marshal(original)
+	goassert.Nil(t, err)
+
+	var decoded DoublyLinkedList[testhelpers.MockStruct]
+	err = json.Unmarshal(data, &decoded)
+
+	goassert.Nil(t, err)
+	verifyDoublyLinkedList(t, []testhelpers.MockStruct{{Prop: 10}, {Prop: 16}}, &decoded)
+	goassert.Nil(t, decoded.equals)
+	testhelpers.PanicWithErrorIs(t, errors.ErrNoEqualityComparer, missingEqualityComparerError, func() {
+		decoded.Contains(testhelpers.MockStruct{Prop: 10})
+	})
+}
@@ -0,0 +1,30 @@
+package doublylinkedlist
+
+import (
+	"io"
+
+	"github.com/golanglibs/gocollections/codec"
+)
+
+/*
+Streams the DoublyLinkedList to the given writer without building the whole payload in memory. Writes a
+versioned header (collection kind and element count) followed by one length-prefixed frame per element from
+head to tail, where each frame is produced by the given element encoder. Returns the number of bytes written
+*/
+func (dll *DoublyLinkedList[T]) EncodeTo(w io.Writer, encodeElement codec.ElementEncoder[T]) (int64, error) {
+	return codec.WriteStream(w, codec.KindDoublyLinkedList, dll.size, dll.ForEach, encodeElement)
+}
+
+/*
+Reads a stream written by DoublyLinkedList.EncodeTo from the given reader and replaces the elements of the
+DoublyLinkedList with the elements decoded by the given element decoder. Elements are added as they are
+decoded, so if an error is returned the DoublyLinkedList holds the elements decoded before the error. The
+equality comparer is left untouched. Returns the number of bytes read
+*/
+func (dll *DoublyLinkedList[T]) DecodeFrom(r io.Reader, decodeElement codec.ElementDecoder[T]) (int64, error) {
+	dll.Clear()
+
+	return codec.ReadStream(r, codec.KindDoublyLinkedList, decodeElement, func(element T) {
+		dll.Add(element)
+	})
+}
@@ -0,0 +1,38 @@
+package doublylinkedlist
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/codec"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_StreamRoundTripShouldPreserveElementsInOrder(t *testing.T) {
+	original := New(10, 16, 14)
+	var buffer bytes.Buffer
+
+	written, err := original.EncodeTo(&buffer, testhelpers.EncodeInt)
+	goassert.Nil(t, err)
+
+	var decoded DoublyLinkedList[int]
+	read, err := decoded.DecodeFrom(&buffer, testhelpers.DecodeInt)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, written, read)
+	verifyDoublyLinkedList(t, []int{10, 16, 14}, &decoded)
+}
+
+func Test_DecodeFromShouldKeepDecodedElements_GivenTruncatedStream(t *testing.T) {
+	original := New(10, 16, 14)
+	var buffer bytes.Buffer
+	original.EncodeTo(&buffer, testhelpers.EncodeInt)
+
+	decoded := New[int]()
+	_, err := decoded.DecodeFrom(bytes.NewReader(buffer.Bytes()[:buffer.Len()-1]), testhelpers.DecodeInt)
+
+	goassert.True(t, errors.Is(err, codec.ErrCorruptedPayload))
+	verifyDoublyLinkedList(t, []int{10, 16}, &decoded)
+}
@@ -5,8 +5,11 @@ import (
 	"testing"
 
 	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/list"
+	"github.com/golanglibs/gocollections/list/arraylist"
 	"github.com/golanglibs/gocollections/testhelpers"
 )
 
@@ -99,7 +102,7 @@ func Test_AtShouldPanic_GivenOutOfRangeIndex(t *testing.T) {
 	expectedError :=
 		fmt.Sprintf("DoublyLinkedList.At could not retrieve element because given index %d is out of range", outOfRangeIndex)
 
-	goassert.PanicWithError(t, expectedError, func() { list.At(outOfRangeIndex) })
+	testhelpers.PanicWithErrorIs(t, errors.ErrIndexOutOfRange, expectedError, func() { list.At(outOfRangeIndex) })
 }
 
 func Test_FrontShouldReturnHeadValue_IfDoublyLinkedListIsNotEmpty(t *testing.T) {
@@ -113,7 +116,7 @@ func Test_FrontShouldPanic_IfDoublyLinkedListIsEmpty(t *testing.T) {
 	list := New[int]()
 
 	expectedError := "DoublyLinkedList.Front failed because the list is empty"
-	goassert.PanicWithError(t, expectedError, func() { list.Front() })
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, expectedError, func() { list.Front() })
 }
 
 func Test_TailShouldReturnHeadValue_IfDoublyLinkedListIsNotEmpty(t *testing.T) {
@@ -127,7 +130,7 @@ func Test_BackShouldPanic_IfDoublyLinkedListIsEmpty(t *testing.T) {
 	list := New[int]()
 
 	expectedError := "DoublyLinkedList.Back failed because the list is empty"
-	goassert.PanicWithError(t, expectedError, func() { list.Back() })
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, expectedError, func() { list.Back() })
 }
 
 func Test_SetShouldSetGivenValueAtGivenIndex(t *testing.T) {
@@ -144,7 +147,7 @@ func Test_SetShouldPanic_GivenOutOfRangeIndex(t *testing.T) {
 	expectedError :=
 		fmt.Sprintf("DoublyLinkedList.Set could not set given value because given index %d is out of range", outOfRangeIndex)
 
-	goassert.PanicWithError(t, expectedError, func() { list.Set(outOfRangeIndex, 8) })
+	testhelpers.PanicWithErrorIs(t, errors.ErrIndexOutOfRange, expectedError, func() { list.Set(outOfRangeIndex, 8) })
 }
 
 func Test_SizeShouldReturnTheCorrectLengthOfList(t *testing.T) {
@@ -303,7 +306,7 @@ func Test_RemoveShouldRemoveGivenElement_And_ReturnFalse_IfGivenElementDoesNotEx
 func Test_RemoveShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
 	list := NewOfAny(testhelpers.NewMockStruct(10))
 
-	goassert.PanicWithError(t, missingEqualityComparerError, func() {
+	testhelpers.PanicWithErrorIs(t, errors.ErrNoEqualityComparer, missingEqualityComparerError, func() {
 		list.Remove(testhelpers.NewMockStruct(10))
 	})
 }
@@ -340,7 +343,7 @@ func Test_RemoveHeadShouldPanic_GivenEmptyList(t *testing.T) {
 	list := New[int]()
 
 	expectedError := "DoublyLinkedList.RemoveFront cannot remove head because list is empty"
-	goassert.PanicWithError(t, expectedError, func() { list.RemoveFront() })
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, expectedError, func() { list.RemoveFront() })
 }
 
 func Test_RemoveTailShouldCorrectlyRemoveAndReturnTailValue_GivenListWithThreeOrMoreElements(t *testing.T) {
@@ -375,7 +378,7 @@ func Test_RemoveBackShouldPanic_GivenEmptyList(t *testing.T) {
 	list := New[int]()
 
 	expectedError := "DoublyLinkedList.RemoveBack cannot remove tail because list is empty"
-	goassert.PanicWithError(t, expectedError, func() { list.RemoveBack() })
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, expectedError, func() { list.RemoveBack() })
 }
 
 func Test_RemoveAtShouldRemoveElementAtGivenIndex_GivenBeginningIndex(t *testing.T) {
@@ -417,8 +420,9 @@ func Test_RemoveAtShouldRemoveTheOnlyElement_GivenDoublyLinkedListWithOneElement
 
 func Test_RemoveAtPanic_GivenOutOfRangeIndex(t *testing.T) {
 	list := New(10, 16, 5)
-	goassert.PanicWithError(
+	testhelpers.PanicWithErrorIs(
 		t,
+		errors.ErrIndexOutOfRange,
 		"DoublyLinkedList.RemoveAt cannot remove element at index 10 because it is out of range",
 		func() {
 			list.RemoveAt(10)
@@ -468,6 +472,21 @@ func Test_ClearShouldEmptyCurrentDoublyLinkedList(t *testing.T) {
 	goassert.Equal(t, 0, list.size)
 }
 
+func Test_ClearShouldInitializeZeroValueDoublyLinkedList(t *testing.T) {
+	var list DoublyLinkedList[int]
+
+	list.Clear()
+	list.Add(10)
+
+	verifyDoublyLinkedList(t, []int{10}, &list)
+}
+
+func Test_ForEachShouldNotExecuteGivenFunction_GivenZeroValueDoublyLinkedList(t *testing.T) {
+	var list DoublyLinkedList[int]
+
+	list.ForEach(func(v *int) { t.Error("ForEach should not execute on empty list") })
+}
+
 func Test_SubListShouldReturnNewCopiedSubList_GivenValidRange(t *testing.T) {
 	list := New(16, 10, 5, 16, 10, 5)
 
@@ -507,7 +526,7 @@ func Test_SubListShouldPanic_GivenInvalidRange(t *testing.T) {
 	}
 
 	for _, c := range testCases {
-		goassert.PanicWithError(t, expectedError, func() { list.SubList(c.start, c.end) })
+		testhelpers.PanicWithErrorIs(t, errors.ErrInvalidRange, expectedError, func() { list.SubList(c.start, c.end) })
 	}
 }
 
@@ -531,3 +550,147 @@ func Test_DoublyLinkedListShouldImplementCollectioner(t *testing.T) {
 	list := New[int]()
 	testCollectioner[int](&list)
 }
+
+func Test_TryAtShouldReturnElementAtGivenIndex_GivenValidIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	element, err := list.TryAt(1)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 16, *element)
+}
+
+func Test_TryAtShouldReturnErrIndexOutOfRange_GivenOutOfRangeIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	element, err := list.TryAt(3)
+
+	testhelpers.ErrorIs(t, errors.ErrIndexOutOfRange, err)
+	goassert.Nil(t, element)
+}
+
+func Test_TrySetShouldReturnErrIndexOutOfRange_GivenOutOfRangeIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	err := list.TrySet(-1, 8)
+
+	testhelpers.ErrorIs(t, errors.ErrIndexOutOfRange, err)
+	goassert.Equal(t, 10, *list.At(0))
+}
+
+func Test_TryFrontAndTryBackShouldReturnErrEmpty_GivenEmptyList(t *testing.T) {
+	list := New[int]()
+
+	front, frontErr := list.TryFront()
+	back, backErr := list.TryBack()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, frontErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, backErr)
+	goassert.Nil(t, front)
+	goassert.Nil(t, back)
+}
+
+func Test_TryRemoveBackShouldReturnErrEmpty_GivenEmptyList(t *testing.T) {
+	list := New[int]()
+
+	err := list.TryRemoveBack()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+}
+
+func Test_TryRemoveAtShouldRemoveElement_GivenValidIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	err := list.TryRemoveAt(1)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 2, list.Size())
+	goassert.Equal(t, 5, *list.At(1))
+}
+
+func Test_TryRemoveAtShouldReturnErrIndexOutOfRange_GivenOutOfRangeIndex(t *testing.T) {
+	list := New(10, 16, 5)
+
+	err := list.TryRemoveAt(3)
+
+	testhelpers.ErrorIs(t, errors.ErrIndexOutOfRange, err)
+	goassert.Equal(t, 3, list.Size())
+}
+
+func Test_TryContainsAndTryIndexOfShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	list := NewOfAny(testhelpers.NewMockStruct(10))
+
+	contains, containsErr := list.TryContains(testhelpers.NewMockStruct(10))
+	index, indexOfErr := list.TryIndexOf(testhelpers.NewMockStruct(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, containsErr)
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, indexOfErr)
+	goassert.False(t, contains)
+	goassert.Equal(t, -1, index)
+}
+
+func Test_TryRemoveShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	list := NewOfAny(testhelpers.NewMockStruct(10))
+
+	removed, err := list.TryRemove(testhelpers.NewMockStruct(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	goassert.False(t, removed)
+	goassert.Equal(t, 1, list.Size())
+}
+
+func Test_TrySubListShouldReturnErrInvalidRange_GivenInvalidRange(t *testing.T) {
+	list := New(10, 16, 5)
+
+	subList, err := list.TrySubList(2, 1)
+
+	testhelpers.ErrorIs(t, errors.ErrInvalidRange, err)
+	goassert.Nil(t, subList)
+}
+
+func Test_EqualsShouldReturnTrue_GivenListWithEqualElementsInSameOrder(t *testing.T) {
+	list := New(10, 16, 5)
+	otherList := arraylist.New(10, 16, 5)
+
+	goassert.True(t, list.Equals(&otherList))
+}
+
+func Test_EqualsShouldReturnFalse_GivenListWithDifferentOrderOrSize(t *testing.T) {
+	list := New(10, 16, 5)
+	reordered := New(10, 5, 16)
+	shorter := New(10, 16)
+
+	goassert.False(t, list.Equals(&reordered))
+	goassert.False(t, list.Equals(&shorter))
+}
+
+func Test_EqualsShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
+	list := NewOfAny(testhelpers.NewMockStruct(10))
+	otherList := NewOfAny(testhelpers.NewMockStruct(10))
+
+	testhelpers.PanicWithErrorIs(t, errors.ErrNoEqualityComparer, missingEqualityComparerError, func() {
+		list.Equals(&otherList)
+	})
+}
+
+func Test_HashCodeShouldBeEqual_GivenEqualLists(t *testing.T) {
+	list := New(10, 16, 5)
+	otherList := arraylist.New(10, 16, 5)
+	reordered := New(5, 16, 10)
+
+	hash := comparer.DefaultHash[int]
+	goassert.Equal(t, list.HashCode(hash), otherList.HashCode(hash))
+	goassert.NotEqual(t, list.HashCode(hash), reordered.HashCode(hash))
+}
+
+func Test_FreezeShouldCopyElements_And_NotReflectLaterChanges(t *testing.T) {
+	list := New(10, 16, 5)
+
+	frozen := list.Freeze()
+	list.Set(0, 1)
+	list.Add(14)
+
+	goassert.Equal(t, 3, frozen.Size())
+	goassert.Equal(t, 10, *frozen.At(0))
+	goassert.True(t, frozen.Contains(5))
+}
@@ -0,0 +1,79 @@
+package doublylinkedlist
+
+import (
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Checks the internal state of the DoublyLinkedList: every node must be the previous node of its next node, the
+nodes must form a chain from the head to the tail without cycles, and the number of nodes must match the size.
+Returns an error wrapping errors.ErrInvariantViolated describing the first violation found, or nil if the
+DoublyLinkedList is consistent. Meant to be used from tests and debug builds
+*/
+func (dll *DoublyLinkedList[T]) Validate() error {
+	if dll.head == nil || dll.tail == nil {
+		if dll.head != dll.tail || dll.size != 0 {
+			return errors.Newf(
+				errors.ErrInvariantViolated,
+				"DoublyLinkedList.Validate found an uninitialized head or tail with size %d",
+				dll.size,
+			)
+		}
+
+		return nil
+	}
+
+	if dll.head.Prev != nil || dll.tail.Next != nil {
+		return errors.Newf(
+			errors.ErrInvariantViolated,
+			"DoublyLinkedList.Validate found a node linked before the head or after the tail",
+		)
+	}
+
+	count := 0
+	previous := dll.head
+	for current := dll.head.Next; current != dll.tail; current = current.Next {
+		if current == nil {
+			return errors.Newf(
+				errors.ErrInvariantViolated,
+				"DoublyLinkedList.Validate found a chain ending after %d nodes without reaching the tail",
+				count,
+			)
+		}
+		if current.Prev != previous {
+			return errors.Newf(
+				errors.ErrInvariantViolated,
+				"DoublyLinkedList.Validate found the node at index %d not linked back to its previous node",
+				count,
+			)
+		}
+
+		count++
+		if count > dll.size {
+			return errors.Newf(
+				errors.ErrInvariantViolated,
+				"DoublyLinkedList.Validate found more nodes than its size %d or a cycle",
+				dll.size,
+			)
+		}
+		previous = current
+	}
+
+	if dll.tail.Prev != previous {
+		return errors.Newf(
+			errors.ErrInvariantViolated,
+			"DoublyLinkedList.Validate found the tail not linked back to the last node",
+		)
+	}
+
+	if count != dll.size {
+		return errors.Newf(
+			errors.ErrInvariantViolated,
+			"DoublyLinkedList.Validate found %d nodes but its size is %d",
+			count,
+			dll.size,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package doublylinkedlist
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_ValidateShouldReturnNil_GivenConsistentDoublyLinkedList(t *testing.T) {
+	var zero DoublyLinkedList[int]
+	empty := New[int]()
+	dll := New(1, 2, 3)
+	dll.Add(4)
+	dll.AddToFront(0)
+	dll.RemoveAt(2)
+
+	goassert.Nil(t, zero.Validate())
+	goassert.Nil(t, empty.Validate())
+	goassert.Nil(t, dll.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenSizeNotMatchingNodeCount(t *testing.T) {
+	dll := New(1, 2, 3)
+	dll.size = 2
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, dll.Validate())
+
+	dll.size = 4
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, dll.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenAsymmetricLinks(t *testing.T) {
+	dll := New(1, 2, 3)
+	dll.head.Next.Next.Prev = dll.head
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, dll.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenBrokenChain(t *testing.T) {
+	dll := New(1, 2, 3)
+	dll.head.Next.Next = nil
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, dll.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenCycle(t *testing.T) {
+	dll := New(1, 2, 3)
+	first := dll.head.Next
+	first.Next.Next.Next = first
+	first.Prev = first.Next.Next
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, dll.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenTailNotLinkedBack(t *testing.T) {
+	dll := New(1, 2, 3)
+	dll.tail.Prev = dll.head
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, dll.Validate())
+}
@@ -0,0 +1,223 @@
+package list
+
+import (
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+)
+
+/*
+Immutable list created by copying the elements of a list once. Since a FrozenList never changes after its
+creation, it can be shared and read by any number of goroutines without synchronization. Elements are handed
+out as references to copies, so the FrozenList cannot be modified through the returned pointers either.
+Implements ReadOnlyLister and ReadOnlyCollectioner
+*/
+type FrozenList[T any] struct {
+	equals   func(*T, *T) bool
+	elements []T
+}
+
+/*
+Creates a FrozenList with copies of the given elements and the given equality comparer and returns it.
+The equality comparer may be nil, in which case IndexOf, Contains and Equals panic
+*/
+func NewFrozenList[T any](equals func(*T, *T) bool, elements ...T) FrozenList[T] {
+	copied := make([]T, len(elements))
+	copy(copied, elements)
+
+	return FrozenList[T]{
+		equals:   equals,
+		elements: copied,
+	}
+}
+
+/*
+Returns a reference to a copy of the element at the given index. Panics if the given index is out of range.
+Implements ReadOnlyLister.At
+*/
+func (f *FrozenList[T]) At(index int) *T {
+	element, err := f.TryAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to a copy of the element at the given index. Returns an error wrapping
+errors.ErrIndexOutOfRange if the given index is out of range.
+Implements ReadOnlyLister.TryAt
+*/
+func (f *FrozenList[T]) TryAt(index int) (*T, error) {
+	if index < 0 || index >= len(f.elements) {
+		return nil, errors.Newf(
+			errors.ErrIndexOutOfRange,
+			"FrozenList.At could not retrieve element because given index %d is out of range",
+			index,
+		)
+	}
+
+	return copyOf(&f.elements[index]), nil
+}
+
+/*
+Returns the number of elements in the FrozenList.
+Implements ReadOnlyLister.Size and ReadOnlyCollectioner.Size
+*/
+func (f *FrozenList[T]) Size() int {
+	return len(f.elements)
+}
+
+/*
+Returns true if the FrozenList is empty.
+Implements ReadOnlyLister.Empty and ReadOnlyCollectioner.Empty
+*/
+func (f *FrozenList[T]) Empty() bool {
+	return len(f.elements) == 0
+}
+
+/*
+Returns a reference to a copy of the first element. Panics if the FrozenList is empty.
+Implements ReadOnlyLister.Front
+*/
+func (f *FrozenList[T]) Front() *T {
+	element, err := f.TryFront()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to a copy of the first element. Returns an error wrapping errors.ErrEmpty if the
+FrozenList is empty.
+Implements ReadOnlyLister.TryFront
+*/
+func (f *FrozenList[T]) TryFront() (*T, error) {
+	if len(f.elements) == 0 {
+		return nil, errors.Newf(errors.ErrEmpty, "FrozenList.Front failed because the list is empty")
+	}
+
+	return copyOf(&f.elements[0]), nil
+}
+
+/*
+Returns a reference to a copy of the last element. Panics if the FrozenList is empty.
+Implements ReadOnlyLister.Back
+*/
+func (f *FrozenList[T]) Back() *T {
+	element, err := f.TryBack()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to a copy of the last element. Returns an error wrapping errors.ErrEmpty if the
+FrozenList is empty.
+Implements ReadOnlyLister.TryBack
+*/
+func (f *FrozenList[T]) TryBack() (*T, error) {
+	if len(f.elements) == 0 {
+		return nil, errors.Newf(errors.ErrEmpty, "FrozenList.Back failed because the list is empty")
+	}
+
+	return copyOf(&f.elements[len(f.elements)-1]), nil
+}
+
+/*
+Returns the index of the first occurrence of the given value or -1 if it is not found. Panics if the equality
+comparer is not set.
+Implements ReadOnlyLister.IndexOf
+*/
+func (f *FrozenList[T]) IndexOf(element T) int {
+	index, err := f.TryIndexOf(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return index
+}
+
+/*
+Returns the index of the first occurrence of the given value or -1 if it is not found. Returns an error
+wrapping errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements ReadOnlyLister.TryIndexOf
+*/
+func (f *FrozenList[T]) TryIndexOf(element T) (int, error) {
+	if err := f.checkEqualityComparer(); err != nil {
+		return -1, err
+	}
+
+	for i := range f.elements {
+		if f.equals(copyOf(&f.elements[i]), &element) {
+			return i, nil
+		}
+	}
+
+	return -1, nil
+}
+
+/*
+Returns true if the given value exists in the FrozenList. Panics if the equality comparer is not set.
+Implements ReadOnlyLister.Contains and ReadOnlyCollectioner.Contains
+*/
+func (f *FrozenList[T]) Contains(element T) bool {
+	return f.IndexOf(element) != -1
+}
+
+/*
+Returns true if the given value exists in the FrozenList. Returns an error wrapping
+errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements ReadOnlyLister.TryContains
+*/
+func (f *FrozenList[T]) TryContains(element T) (bool, error) {
+	index, err := f.TryIndexOf(element)
+	return index != -1, err
+}
+
+/*
+Returns true if the given list has the same size as the FrozenList and equal elements in the same order.
+Panics if the equality comparer is not set.
+Implements ReadOnlyLister.Equals
+*/
+func (f *FrozenList[T]) Equals(other ReadOnlyLister[T]) bool {
+	if err := f.checkEqualityComparer(); err != nil {
+		panic(err)
+	}
+
+	return generic.SequenceEqual[T](f, other, f.equals)
+}
+
+/*
+Returns a hash code which depends on the elements of the FrozenList and their order.
+Implements ReadOnlyLister.HashCode
+*/
+func (f *FrozenList[T]) HashCode(hash func(*T) uint64) uint64 {
+	return generic.OrderedHashCode[T](f, hash)
+}
+
+/*
+Iterates through each element of the FrozenList and executes the given function on a reference to a copy of
+the element.
+Implements ReadOnlyLister.ForEach and ReadOnlyCollectioner.ForEach
+*/
+func (f *FrozenList[T]) ForEach(do func(*T)) {
+	for i := range f.elements {
+		do(copyOf(&f.elements[i]))
+	}
+}
+
+func (f *FrozenList[T]) checkEqualityComparer() error {
+	if f.equals == nil {
+		return errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		)
+	}
+
+	return nil
+}
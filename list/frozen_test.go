@@ -0,0 +1,104 @@
+package list
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testReadOnlyLister[T any](l ReadOnlyLister[T]) {}
+
+func testReadOnlyCollectioner[T any](c generic.ReadOnlyCollectioner[T]) {}
+
+func Test_FrozenListShouldImplementReadOnlyListerAndReadOnlyCollectioner(t *testing.T) {
+	frozen := NewFrozenList[int](nil)
+
+	testReadOnlyLister[int](&frozen)
+	testReadOnlyCollectioner[int](&frozen)
+}
+
+func Test_NewFrozenListShouldCopyGivenElements(t *testing.T) {
+	elements := []int{10, 16, 5}
+
+	frozen := NewFrozenList(comparer.DefaultEquals[int], elements...)
+	elements[0] = 1
+
+	goassert.Equal(t, 3, frozen.Size())
+	goassert.Equal(t, 10, *frozen.At(0))
+	goassert.Equal(t, 10, *frozen.Front())
+	goassert.Equal(t, 5, *frozen.Back())
+}
+
+func Test_FrozenListShouldNotBeModifiable_ThroughReturnedReferences(t *testing.T) {
+	frozen := NewFrozenList(comparer.DefaultEquals[int], 10, 16, 5)
+
+	*frozen.At(0) = 1
+	*frozen.Front() = 1
+	frozen.ForEach(func(element *int) {
+		*element = 1
+	})
+
+	goassert.Equal(t, 10, *frozen.At(0))
+	goassert.Equal(t, 1, frozen.IndexOf(16))
+	goassert.True(t, frozen.Contains(5))
+}
+
+func Test_FrozenListTryMethodsShouldReturnErrors(t *testing.T) {
+	empty := NewFrozenList[int](nil)
+
+	_, atErr := empty.TryAt(0)
+	_, frontErr := empty.TryFront()
+	_, backErr := empty.TryBack()
+	_, containsErr := empty.TryContains(10)
+
+	testhelpers.ErrorIs(t, errors.ErrIndexOutOfRange, atErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, frontErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, backErr)
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, containsErr)
+}
+
+func Test_FrozenListShouldPanic_GivenOutOfRangeIndex(t *testing.T) {
+	frozen := NewFrozenList(comparer.DefaultEquals[int], 10)
+
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrIndexOutOfRange,
+		"FrozenList.At could not retrieve element because given index 1 is out of range",
+		func() { frozen.At(1) },
+	)
+}
+
+func Test_FrozenListEqualsAndHashCodeShouldCompareElementsInOrder(t *testing.T) {
+	frozen := NewFrozenList(comparer.DefaultEquals[int], 10, 16, 5)
+	equal := NewFrozenList(comparer.DefaultEquals[int], 10, 16, 5)
+	reordered := NewFrozenList(comparer.DefaultEquals[int], 5, 16, 10)
+
+	goassert.True(t, frozen.Equals(&equal))
+	goassert.False(t, frozen.Equals(&reordered))
+	goassert.Equal(t, frozen.HashCode(comparer.DefaultHash[int]), equal.HashCode(comparer.DefaultHash[int]))
+}
+
+func Test_FrozenListShouldBeReadableFromManyGoroutines(t *testing.T) {
+	frozen := NewFrozenList(comparer.DefaultEquals[int], 10, 16, 5)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sum := 0
+			frozen.ForEach(func(element *int) {
+				sum += *element
+			})
+			if sum != 31 || !frozen.Contains(16) {
+				t.Errorf("Expected to read the same elements from every goroutine")
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,14 +1,19 @@
 package list
 
-type Lister[T any] interface {
-	/* Sets the equality comparer to the given function */
-	SetEqualityComparer(equals func(*T, *T) bool)
-
+/*
+Read operations of list-like collections. A ReadOnlyLister can be passed to callers which must not mutate the
+list. Note that a Lister also satisfies ReadOnlyLister, so wrap it with Unmodifiable or Freeze it to prevent
+callers from type-asserting it back
+*/
+type ReadOnlyLister[T any] interface {
 	/* Retrieves and returns a reference to the element at the given index */
 	At(index int) *T
 
-	/* Sets the given value at the given index */
-	Set(index int, value T)
+	/*
+		Retrieves and returns a reference to the element at the given index. Returns an error wrapping
+		errors.ErrIndexOutOfRange instead of panicking if the given index is out of range
+	*/
+	TryAt(index int) (*T, error)
 
 	/* Returns the size of the List */
 	Size() int
@@ -19,15 +24,83 @@ type Lister[T any] interface {
 	/* Returns a reference to the value at the front of the list */
 	Front() *T
 
+	/*
+		Returns a reference to the value at the front of the list. Returns an error wrapping errors.ErrEmpty
+		instead of panicking if the list is empty
+	*/
+	TryFront() (*T, error)
+
 	/* Returns a reference to the value at the back of the list */
 	Back() *T
 
+	/*
+		Returns a reference to the value at the back of the list. Returns an error wrapping errors.ErrEmpty
+		instead of panicking if the list is empty
+	*/
+	TryBack() (*T, error)
+
+	/*
+		Returns the index of the first occurrence of the given value. Returns -1 if the element is not found.
+		Equality is determined by the equality comparer set either automatically (because the types of the
+		the elements are "comparable") or set manually (through Lister.SetEqualityComparer)
+	*/
+	IndexOf(element T) int
+
+	/*
+		Returns the index of the first occurrence of the given value or -1 if it is not found. Returns an error
+		wrapping errors.ErrNoEqualityComparer instead of panicking if the equality comparer is not set
+	*/
+	TryIndexOf(element T) (int, error)
+
+	/* Returns true if the given value exists in the list. Otherwise, false */
+	Contains(element T) bool
+
+	/*
+		Returns true if the given value exists in the list. Returns an error wrapping
+		errors.ErrNoEqualityComparer instead of panicking if the equality comparer is not set
+	*/
+	TryContains(element T) (bool, error)
+
+	/*
+		Returns true if the given list has the same size and equal elements in the same order. Panics if the
+		equality comparer is not set
+	*/
+	Equals(other ReadOnlyLister[T]) bool
+
+	/*
+		Returns a hash code which depends on the elements and their order. Elements are hashed with the given
+		function, which must return equal hashes for elements the equality comparer considers equal
+	*/
+	HashCode(hash func(*T) uint64) uint64
+
+	/* Iterates through each element in the list and executes the given function */
+	ForEach(do func(*T))
+}
+
+type Lister[T any] interface {
+	ReadOnlyLister[T]
+
+	/* Sets the equality comparer to the given function */
+	SetEqualityComparer(equals func(*T, *T) bool)
+
+	/* Sets the given value at the given index */
+	Set(index int, value T)
+
+	/*
+		Sets the given value at the given index. Returns an error wrapping errors.ErrIndexOutOfRange instead of
+		panicking if the given index is out of range
+	*/
+	TrySet(index int, value T) error
+
 	/* Appends the given value to the back of the list */
 	Add(element T) bool
 
 	/* Removes the last element of the list */
 	RemoveBack()
 
+	/* Removes the last element of the list. Returns an error instead of panicking if the list is empty */
+	TryRemoveBack() error
+
 	/* Inserts the given value at the given index */
 	Insert(index int, value T) bool
 
@@ -37,24 +110,29 @@ type Lister[T any] interface {
 	/* Removes the first element of the list */
 	RemoveFront()
 
+	/* Removes the first element of the list. Returns an error instead of panicking if the list is empty */
+	TryRemoveFront() error
+
 	/*
 		Removes the first occurrence of the element if found. Returns true if the given element was found and
 		removed. Otherwise, false
 	*/
 	Remove(element T) bool
 
+	/*
+		Removes the first occurrence of the element if found. Returns an error wrapping
+		errors.ErrNoEqualityComparer instead of panicking if the equality comparer is not set
+	*/
+	TryRemove(element T) (bool, error)
+
 	/* Removes the element at the given index. Panics if the given index is out of range */
 	RemoveAt(index int)
 
 	/*
-		Returns the index of the first occurrence of the given value. Returns -1 if the element is not found.
-		Equality is determined by the equality comparer set either automatically (because the types of the
-		the elements are "comparable") or set manually (through Lister.SetEqualityComparer)
+		Removes the element at the given index. Returns an error wrapping errors.ErrIndexOutOfRange instead of
+		panicking if the given index is out of range
 	*/
-	IndexOf(element T) int
-
-	/* Returns true if the given value exists in the list. Otherwise, false */
-	Contains(element T) bool
+	TryRemoveAt(index int) error
 
 	/*
 		Returns a sub list of the current list from "start" index (inclusive) to "end" index (exclusive).
@@ -62,9 +140,12 @@ type Lister[T any] interface {
 	*/
 	SubList(start int, end int) Lister[T]
 
+	/*
+		Returns a copied sub list of the current list from "start" index (inclusive) to "end" index (exclusive).
+		Returns an error wrapping errors.ErrInvalidRange instead of panicking if the given range is invalid
+	*/
+	TrySubList(start int, end int) (Lister[T], error)
+
 	/* Empties the list. How the emptying is performed depends on the implementation */
 	Clear()
-
-	/* Iterates through each element in the list and executes the given function */
-	ForEach(do func(*T))
 }
@@ -0,0 +1,107 @@
+package persistentvector
+
+import (
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Mutable builder of a Vector. A Transient modifies the nodes it owns in place, so a batch of edits does not
+create intermediate versions. Nodes shared with the Vector it was created from are copied before they are
+modified, so that Vector is never affected. Call Persistent to get the resulting Vector. After that the
+Transient cannot be used anymore.
+Transient is not thread safe
+*/
+type Transient[T any] struct {
+	edit   *bool
+	equals func(*T, *T) bool
+	trie   trie[T]
+}
+
+/*
+Returns the number of elements in the Transient
+*/
+func (t *Transient[T]) Size() int {
+	t.ensureEditable("Size")
+	return t.trie.count - t.trie.offset
+}
+
+/*
+Returns a reference to a copy of the element at the given index. Panics if the given index is out of range
+*/
+func (t *Transient[T]) At(index int) *T {
+	t.ensureEditable("At")
+	if index < 0 || index >= t.Size() {
+		panic(errors.Newf(
+			errors.ErrIndexOutOfRange,
+			"Transient.At could not retrieve element because given index %d is out of range",
+			index,
+		))
+	}
+
+	absolute := index + t.trie.offset
+	element := t.trie.leafFor(absolute)[absolute&mask]
+
+	return &element
+}
+
+/*
+Appends the given element to the back of the Transient
+*/
+func (t *Transient[T]) Append(element T) {
+	t.ensureEditable("Append")
+	t.trie.append(element, t.edit)
+}
+
+/*
+Sets the given value at the given index. Panics if the given index is out of range
+*/
+func (t *Transient[T]) Set(index int, value T) {
+	t.ensureEditable("Set")
+	if index < 0 || index >= t.Size() {
+		panic(errors.Newf(
+			errors.ErrIndexOutOfRange,
+			"Transient.Set could not set given value because given index %d is out of range",
+			index,
+		))
+	}
+
+	t.trie.set(index+t.trie.offset, value, t.edit)
+}
+
+/*
+Removes the last element of the Transient. Panics if the Transient is empty
+*/
+func (t *Transient[T]) Pop() {
+	t.ensureEditable("Pop")
+	if t.Size() == 0 {
+		panic(errors.Newf(errors.ErrEmpty, "Transient.Pop failed because the vector is empty"))
+	}
+
+	t.trie.pop(t.edit)
+}
+
+/*
+Returns a Vector with the elements of the Transient and ends the Transient. Any further call on the Transient
+panics
+*/
+func (t *Transient[T]) Persistent() Vector[T] {
+	t.ensureEditable("Persistent")
+
+	// nodes owned by the Transient become immutable once its token is released
+	t.edit = nil
+
+	trie := t.trie
+	trie.tail = trie.tail[:len(trie.tail):len(trie.tail)]
+
+	return Vector[T]{equals: t.equals, trie: trie}
+}
+
+func (t *Transient[T]) ensureEditable(method string) {
+	if t.edit == nil {
+		panic(errors.Newf(
+			errors.ErrUnmodifiable,
+			"Transient.%s failed because Persistent was already called",
+			method,
+		))
+	}
+}
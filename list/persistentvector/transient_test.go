@@ -0,0 +1,117 @@
+package persistentvector
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_TransientShouldBuildVector(t *testing.T) {
+	transient := New[int]().Transient()
+	for i := 0; i < 2000; i++ {
+		transient.Append(i)
+	}
+	transient.Set(1500, -1)
+	transient.Pop()
+
+	vector := transient.Persistent()
+
+	expected := sequence(0, 1999)
+	expected[1500] = -1
+	goassert.Equal(t, 1999, vector.Size())
+	goassert.DeepEqual(t, expected, toSlice(vector))
+}
+
+func Test_TransientShouldNotAffectVectorItWasCreatedFrom(t *testing.T) {
+	original := New(sequence(0, 1100)...)
+
+	transient := original.Transient()
+	for i := 0; i < 1100; i++ {
+		transient.Set(i, -i)
+	}
+	for i := 0; i < 100; i++ {
+		transient.Pop()
+	}
+	transient.Append(-1)
+	edited := transient.Persistent()
+
+	goassert.DeepEqual(t, sequence(0, 1100), toSlice(original))
+	goassert.Equal(t, 1001, edited.Size())
+	goassert.Equal(t, -999, *edited.At(999))
+	goassert.Equal(t, -1, *edited.Back())
+}
+
+func Test_TransientShouldNotAffectVectorItCreated(t *testing.T) {
+	transient := New(sequence(0, 40)...).Transient()
+	first := transient.Persistent()
+
+	second := first.Transient()
+	second.Set(0, -1)
+	second.Set(35, -1)
+	second.Append(-1)
+
+	goassert.DeepEqual(t, sequence(0, 40), toSlice(first))
+	goassert.Equal(t, 41, second.Size())
+	goassert.Equal(t, -1, *second.At(35))
+}
+
+func Test_TransientOfSliceShouldOnlyExposeSlicedElements(t *testing.T) {
+	transient := New(sequence(0, 100)...).Slice(10, 50).Transient()
+
+	transient.Set(0, -1)
+	transient.Append(-2)
+
+	vector := transient.Persistent()
+	goassert.Equal(t, 41, vector.Size())
+	goassert.Equal(t, -1, *vector.Front())
+	goassert.Equal(t, 11, *vector.At(1))
+	goassert.Equal(t, -2, *vector.Back())
+}
+
+func Test_TransientShouldPanic_GivenInvalidArguments(t *testing.T) {
+	transient := New[int]().Transient()
+
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Transient.Pop failed because the vector is empty", func() {
+		transient.Pop()
+	})
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrIndexOutOfRange,
+		"Transient.Set could not set given value because given index 0 is out of range",
+		func() {
+			transient.Set(0, 1)
+		},
+	)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrIndexOutOfRange,
+		"Transient.At could not retrieve element because given index 0 is out of range",
+		func() {
+			transient.At(0)
+		},
+	)
+}
+
+func Test_TransientShouldPanic_AfterPersistentIsCalled(t *testing.T) {
+	transient := New(10, 16, 5).Transient()
+	transient.Persistent()
+
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"Transient.Append failed because Persistent was already called",
+		func() {
+			transient.Append(1)
+		},
+	)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"Transient.Persistent failed because Persistent was already called",
+		func() {
+			transient.Persistent()
+		},
+	)
+}
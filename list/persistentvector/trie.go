@@ -0,0 +1,301 @@
+package persistentvector
+
+const (
+	bits  = 5
+	width = 1 << bits
+	mask  = width - 1
+)
+
+/*
+Node of the 32-way bit-partitioned trie. Internal nodes only hold children and leaves only hold elements.
+"edit" is the token of the Transient which owns the node and may therefore modify it in place. Nodes of
+persistent vectors are never modified
+*/
+type node[T any] struct {
+	edit     *bool
+	children []*node[T]
+	elements []T
+}
+
+func (n *node[T]) editable(edit *bool) *node[T] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+
+	cloned := &node[T]{edit: edit}
+	if n.children != nil {
+		cloned.children = make([]*node[T], len(n.children), width)
+		copy(cloned.children, n.children)
+	}
+	if n.elements != nil {
+		cloned.elements = make([]T, len(n.elements))
+		copy(cloned.elements, n.elements)
+	}
+
+	return cloned
+}
+
+/*
+Elements of the vector stored in a trie plus a tail holding the last (up to 32) elements, so that appending
+and popping only touch the trie once every 32 elements. Elements before "offset" belong to a vector this one
+was sliced from and are never visible
+*/
+type trie[T any] struct {
+	count  int
+	offset int
+	shift  uint
+	root   *node[T]
+	tail   []T
+}
+
+func emptyTrie[T any]() trie[T] {
+	return trie[T]{
+		shift: bits,
+		root:  &node[T]{},
+	}
+}
+
+func (t *trie[T]) tailOffset() int {
+	if t.count < width {
+		return 0
+	}
+
+	return ((t.count - 1) >> bits) << bits
+}
+
+/*
+Returns the leaf elements holding the given absolute index
+*/
+func (t *trie[T]) leafFor(index int) []T {
+	if index >= t.tailOffset() {
+		return t.tail
+	}
+
+	n := t.root
+	for level := t.shift; level > 0; level -= bits {
+		n = n.children[(index>>level)&mask]
+	}
+
+	return n.elements
+}
+
+/*
+Appends the given element. With a nil edit token, the tail and every touched node are copied. With the token
+of a Transient, the nodes and the tail it owns are modified in place
+*/
+func (t *trie[T]) append(element T, edit *bool) {
+	if t.count-t.tailOffset() < width {
+		if edit == nil {
+			tail := make([]T, len(t.tail)+1)
+			copy(tail, t.tail)
+			tail[len(t.tail)] = element
+			t.tail = tail
+		} else {
+			t.tail = append(t.tail, element)
+		}
+		t.count++
+		return
+	}
+
+	tailNode := &node[T]{edit: edit, elements: t.tail}
+	if (t.count >> bits) > (1 << t.shift) {
+		t.root = &node[T]{
+			edit:     edit,
+			children: []*node[T]{t.root, newPath(t.shift, tailNode, edit)},
+		}
+		t.shift += bits
+	} else {
+		t.root = t.pushTail(t.shift, t.root, tailNode, edit)
+	}
+
+	t.tail = newTail[T](edit)
+	t.tail = append(t.tail, element)
+	t.count++
+}
+
+func newTail[T any](edit *bool) []T {
+	if edit == nil {
+		return make([]T, 0, 1)
+	}
+
+	return make([]T, 0, width)
+}
+
+func newPath[T any](level uint, n *node[T], edit *bool) *node[T] {
+	if level == 0 {
+		return n
+	}
+
+	return &node[T]{
+		edit:     edit,
+		children: []*node[T]{newPath(level-bits, n, edit)},
+	}
+}
+
+func (t *trie[T]) pushTail(level uint, parent *node[T], tailNode *node[T], edit *bool) *node[T] {
+	result := parent.editable(edit)
+	childIndex := ((t.count - 1) >> level) & mask
+
+	var child *node[T]
+	if level == bits {
+		child = tailNode
+	} else if childIndex < len(parent.children) {
+		child = t.pushTail(level-bits, parent.children[childIndex], tailNode, edit)
+	} else {
+		child = newPath(level-bits, tailNode, edit)
+	}
+
+	if childIndex < len(result.children) {
+		result.children[childIndex] = child
+	} else {
+		result.children = append(result.children, child)
+	}
+
+	return result
+}
+
+/*
+Sets the element at the given absolute index, which must be valid
+*/
+func (t *trie[T]) set(index int, element T, edit *bool) {
+	if index >= t.tailOffset() {
+		if edit == nil {
+			tail := make([]T, len(t.tail))
+			copy(tail, t.tail)
+			t.tail = tail
+		}
+		t.tail[index&mask] = element
+		return
+	}
+
+	t.root = setInNode(t.shift, t.root, index, element, edit)
+}
+
+func setInNode[T any](level uint, n *node[T], index int, element T, edit *bool) *node[T] {
+	result := n.editable(edit)
+	if level == 0 {
+		result.elements[index&mask] = element
+		return result
+	}
+
+	childIndex := (index >> level) & mask
+	result.children[childIndex] = setInNode(level-bits, n.children[childIndex], index, element, edit)
+
+	return result
+}
+
+/*
+Removes the last element. The trie must not be empty
+*/
+func (t *trie[T]) pop(edit *bool) {
+	if t.count == 1 {
+		*t = emptyTrie[T]()
+		return
+	}
+
+	if t.count-t.tailOffset() > 1 {
+		t.tail = t.tail[:len(t.tail)-1]
+		t.count--
+		return
+	}
+
+	leaf := t.leafFor(t.count - 2)
+	tail := newTail[T](edit)
+	t.tail = append(tail, leaf...)
+
+	root := t.popTail(t.shift, t.root, edit)
+	if root == nil {
+		root = &node[T]{edit: edit}
+	}
+	if t.shift > bits && len(root.children) == 1 {
+		root = root.children[0]
+		t.shift -= bits
+	}
+
+	t.root = root
+	t.count--
+}
+
+func (t *trie[T]) popTail(level uint, n *node[T], edit *bool) *node[T] {
+	childIndex := ((t.count - 2) >> level) & mask
+	if level > bits {
+		child := t.popTail(level-bits, n.children[childIndex], edit)
+		if child == nil && childIndex == 0 {
+			return nil
+		}
+
+		result := n.editable(edit)
+		if child == nil {
+			result.children = result.children[:childIndex]
+		} else {
+			result.children[childIndex] = child
+		}
+
+		return result
+	}
+
+	if childIndex == 0 {
+		return nil
+	}
+
+	result := n.editable(edit)
+	result.children = result.children[:childIndex]
+
+	return result
+}
+
+/*
+Keeps only the first "count" elements (including the elements before the offset). Every node which is still
+needed is shared
+*/
+func (t *trie[T]) truncate(count int) {
+	if count == t.count {
+		return
+	}
+	if count == 0 {
+		*t = emptyTrie[T]()
+		return
+	}
+
+	if count > t.tailOffset() {
+		t.tail = t.tail[:count-t.tailOffset()]
+		t.count = count
+		return
+	}
+
+	leaf := t.leafFor(count - 1)
+	t.tail = leaf[:((count-1)&mask)+1]
+
+	rootCount := count - len(t.tail)
+	if rootCount == 0 {
+		t.root = &node[T]{}
+		t.shift = bits
+	} else {
+		t.root = take(t.shift, t.root, rootCount)
+		for t.shift > bits && len(t.root.children) == 1 {
+			t.root = t.root.children[0]
+			t.shift -= bits
+		}
+	}
+
+	t.count = count
+}
+
+/*
+Returns a copy of the given internal node which only keeps the children holding the first "count" elements.
+"count" is always a multiple of the leaf size
+*/
+func take[T any](level uint, n *node[T], count int) *node[T] {
+	childCount := ((count - 1) >> level) + 1
+	result := &node[T]{
+		children: make([]*node[T], childCount, width),
+	}
+	copy(result.children, n.children[:childCount])
+
+	if level > bits {
+		last := childCount - 1
+		result.children[last] = take(level-bits, n.children[last], count-(last<<level))
+	}
+
+	return result
+}
@@ -0,0 +1,432 @@
+package persistentvector
+
+import (
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/list"
+	"github.com/golanglibs/gocollections/list/arraylist"
+)
+
+/*
+Persistent vector implemented as a 32-way bit-partitioned trie with a tail, like the vectors of Clojure and
+Scala. A Vector is immutable: Append, Set, Pop and Slice return new versions which share most of their
+structure with the Vector they were created from, so keeping many versions around is cheap. Lookups and
+updates take O(log32 n) time, which is practically constant.
+Since a Vector never changes, it can be shared and read by any number of goroutines without synchronization.
+Elements are handed out as references to copies, so a Vector cannot be modified through the returned pointers.
+Use Transient to perform many edits in a row without creating intermediate versions.
+Implements ReadOnlyLister and ReadOnlyCollectioner
+*/
+type Vector[T any] struct {
+	equals func(*T, *T) bool
+	trie   trie[T]
+}
+
+/*
+Creates a new instance of Vector with the given elements with a default equality comparer and returns it.
+If no elements are given, then an empty vector is created. Elements must be comparable
+*/
+func New[K comparable](elements ...K) Vector[K] {
+	return fromSlice(comparer.DefaultEquals[K], elements)
+}
+
+/*
+Creates a new instance of Vector with the given elements with nil equality comparer and returns it.
+If no elements are given, then an empty vector is created. Elements can be of any type
+*/
+func NewOfAny[T any](elements ...T) Vector[T] {
+	return fromSlice(nil, elements)
+}
+
+/*
+Creates a new instance of Vector with the elements of the given collection, for example an ArrayList, with a
+default equality comparer and returns it. Elements must be comparable
+*/
+func NewFromCollection[K comparable](c generic.ReadOnlyCollectioner[K]) Vector[K] {
+	return fromCollection(comparer.DefaultEquals[K], c)
+}
+
+/*
+Creates a new instance of Vector with the elements of the given collection, for example an ArrayList, with nil
+equality comparer and returns it. Elements can be of any type
+*/
+func NewOfAnyFromCollection[T any](c generic.ReadOnlyCollectioner[T]) Vector[T] {
+	return fromCollection(nil, c)
+}
+
+func fromSlice[T any](equals func(*T, *T) bool, elements []T) Vector[T] {
+	transient := Vector[T]{equals: equals, trie: emptyTrie[T]()}.Transient()
+	for _, element := range elements {
+		transient.Append(element)
+	}
+
+	return transient.Persistent()
+}
+
+func fromCollection[T any](equals func(*T, *T) bool, c generic.ReadOnlyCollectioner[T]) Vector[T] {
+	transient := Vector[T]{equals: equals, trie: emptyTrie[T]()}.Transient()
+	c.ForEach(func(element *T) {
+		transient.Append(*element)
+	})
+
+	return transient.Persistent()
+}
+
+/*
+Returns a copy of the Vector which uses the given equality comparer. The elements are shared
+*/
+func (v Vector[T]) WithEqualityComparer(equals func(*T, *T) bool) Vector[T] {
+	v.equals = equals
+	return v
+}
+
+/*
+Returns a new ArrayList with the elements of the Vector in order and the equality comparer of the Vector
+*/
+func (v Vector[T]) ToArrayList() arraylist.List[T] {
+	elements := make([]T, 0, v.Size())
+	v.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	l := arraylist.NewOfAny(elements...)
+	l.SetEqualityComparer(v.equals)
+
+	return l
+}
+
+/*
+Returns the number of elements in the Vector.
+Implements ReadOnlyLister.Size and ReadOnlyCollectioner.Size
+*/
+func (v Vector[T]) Size() int {
+	if v.trie.root == nil {
+		return 0
+	}
+
+	return v.trie.count - v.trie.offset
+}
+
+/*
+Returns true if the Vector is empty.
+Implements ReadOnlyLister.Empty and ReadOnlyCollectioner.Empty
+*/
+func (v Vector[T]) Empty() bool {
+	return v.Size() == 0
+}
+
+/*
+Returns a reference to a copy of the element at the given index. Panics if the given index is out of range.
+Implements ReadOnlyLister.At
+*/
+func (v Vector[T]) At(index int) *T {
+	element, err := v.TryAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to a copy of the element at the given index. Returns an error wrapping
+errors.ErrIndexOutOfRange if the given index is out of range.
+Implements ReadOnlyLister.TryAt
+*/
+func (v Vector[T]) TryAt(index int) (*T, error) {
+	if index < 0 || index >= v.Size() {
+		return nil, errors.Newf(
+			errors.ErrIndexOutOfRange,
+			"Vector.At could not retrieve element because given index %d is out of range",
+			index,
+		)
+	}
+
+	return v.at(index), nil
+}
+
+func (v Vector[T]) at(index int) *T {
+	absolute := index + v.trie.offset
+	element := v.trie.leafFor(absolute)[absolute&mask]
+
+	return &element
+}
+
+/*
+Returns a reference to a copy of the first element. Panics if the Vector is empty.
+Implements ReadOnlyLister.Front
+*/
+func (v Vector[T]) Front() *T {
+	element, err := v.TryFront()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to a copy of the first element. Returns an error wrapping errors.ErrEmpty if the Vector is
+empty.
+Implements ReadOnlyLister.TryFront
+*/
+func (v Vector[T]) TryFront() (*T, error) {
+	if v.Empty() {
+		return nil, errors.Newf(errors.ErrEmpty, "Vector.Front failed because the vector is empty")
+	}
+
+	return v.at(0), nil
+}
+
+/*
+Returns a reference to a copy of the last element. Panics if the Vector is empty.
+Implements ReadOnlyLister.Back
+*/
+func (v Vector[T]) Back() *T {
+	element, err := v.TryBack()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to a copy of the last element. Returns an error wrapping errors.ErrEmpty if the Vector is
+empty.
+Implements ReadOnlyLister.TryBack
+*/
+func (v Vector[T]) TryBack() (*T, error) {
+	if v.Empty() {
+		return nil, errors.Newf(errors.ErrEmpty, "Vector.Back failed because the vector is empty")
+	}
+
+	return v.at(v.Size() - 1), nil
+}
+
+/*
+Returns a new version of the Vector with the given element appended to the back
+*/
+func (v Vector[T]) Append(element T) Vector[T] {
+	v.ensureInitialized()
+	v.trie.append(element, nil)
+
+	return v
+}
+
+/*
+Returns a new version of the Vector with the given value at the given index. Panics if the given index is out
+of range
+*/
+func (v Vector[T]) Set(index int, value T) Vector[T] {
+	updated, err := v.TrySet(index, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return updated
+}
+
+/*
+Returns a new version of the Vector with the given value at the given index. Returns an error wrapping
+errors.ErrIndexOutOfRange if the given index is out of range
+*/
+func (v Vector[T]) TrySet(index int, value T) (Vector[T], error) {
+	if index < 0 || index >= v.Size() {
+		return v, errors.Newf(
+			errors.ErrIndexOutOfRange,
+			"Vector.Set could not set given value because given index %d is out of range",
+			index,
+		)
+	}
+
+	v.trie.set(index+v.trie.offset, value, nil)
+
+	return v, nil
+}
+
+/*
+Returns a new version of the Vector without its last element. Panics if the Vector is empty
+*/
+func (v Vector[T]) Pop() Vector[T] {
+	popped, err := v.TryPop()
+	if err != nil {
+		panic(err)
+	}
+
+	return popped
+}
+
+/*
+Returns a new version of the Vector without its last element. Returns an error wrapping errors.ErrEmpty if the
+Vector is empty
+*/
+func (v Vector[T]) TryPop() (Vector[T], error) {
+	if v.Empty() {
+		return v, errors.Newf(errors.ErrEmpty, "Vector.Pop failed because the vector is empty")
+	}
+
+	v.trie.pop(nil)
+
+	return v, nil
+}
+
+/*
+Returns a new Vector with the elements from "start" index (inclusive) to "end" index (exclusive). The slice
+shares its whole structure with the Vector, so it takes O(log32 n) time regardless of its length. Note that
+the slice keeps the elements before "start" reachable until it is garbage collected. Panics if the given range
+is invalid
+*/
+func (v Vector[T]) Slice(start int, end int) Vector[T] {
+	slice, err := v.TrySlice(start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return slice
+}
+
+/*
+Returns a new Vector with the elements from "start" index (inclusive) to "end" index (exclusive), sharing its
+structure with the Vector. Returns an error wrapping errors.ErrInvalidRange if the given range is invalid
+*/
+func (v Vector[T]) TrySlice(start int, end int) (Vector[T], error) {
+	if start < 0 || end > v.Size() || start > end {
+		return v, errors.Newf(
+			errors.ErrInvalidRange,
+			"Vector.Slice cannot create a slice because invalid range [%d, %d) was given",
+			start,
+			end,
+		)
+	}
+
+	v.ensureInitialized()
+	v.trie.truncate(v.trie.offset + end)
+	v.trie.offset += start
+
+	return v, nil
+}
+
+/*
+Returns the index of the first occurrence of the given value or -1 if it is not found. Panics if the equality
+comparer is not set.
+Implements ReadOnlyLister.IndexOf
+*/
+func (v Vector[T]) IndexOf(element T) int {
+	index, err := v.TryIndexOf(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return index
+}
+
+/*
+Returns the index of the first occurrence of the given value or -1 if it is not found. Returns an error
+wrapping errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements ReadOnlyLister.TryIndexOf
+*/
+func (v Vector[T]) TryIndexOf(element T) (int, error) {
+	if err := v.checkEqualityComparer(); err != nil {
+		return -1, err
+	}
+
+	for i := 0; i < v.Size(); i++ {
+		if v.equals(v.at(i), &element) {
+			return i, nil
+		}
+	}
+
+	return -1, nil
+}
+
+/*
+Returns true if the given value exists in the Vector. Panics if the equality comparer is not set.
+Implements ReadOnlyLister.Contains and ReadOnlyCollectioner.Contains
+*/
+func (v Vector[T]) Contains(element T) bool {
+	return v.IndexOf(element) != -1
+}
+
+/*
+Returns true if the given value exists in the Vector. Returns an error wrapping errors.ErrNoEqualityComparer
+if the equality comparer is not set.
+Implements ReadOnlyLister.TryContains
+*/
+func (v Vector[T]) TryContains(element T) (bool, error) {
+	index, err := v.TryIndexOf(element)
+	return index != -1, err
+}
+
+/*
+Returns true if the given list has the same size as the Vector and equal elements in the same order. Panics
+if the equality comparer is not set.
+Implements ReadOnlyLister.Equals
+*/
+func (v Vector[T]) Equals(other list.ReadOnlyLister[T]) bool {
+	if err := v.checkEqualityComparer(); err != nil {
+		panic(err)
+	}
+
+	return generic.SequenceEqual[T](v, other, v.equals)
+}
+
+/*
+Returns a hash code which depends on the elements of the Vector and their order.
+Implements ReadOnlyLister.HashCode
+*/
+func (v Vector[T]) HashCode(hash func(*T) uint64) uint64 {
+	return generic.OrderedHashCode[T](v, hash)
+}
+
+/*
+Iterates through each element of the Vector in order and executes the given function on a reference to a copy
+of the element.
+Implements ReadOnlyLister.ForEach and ReadOnlyCollectioner.ForEach
+*/
+func (v Vector[T]) ForEach(do func(*T)) {
+	for i := v.trie.offset; i < v.trie.count; {
+		leaf := v.trie.leafFor(i)
+		for j := i & mask; j < len(leaf) && i < v.trie.count; j++ {
+			element := leaf[j]
+			do(&element)
+			i++
+		}
+	}
+}
+
+/*
+Returns a Transient holding the elements of the Vector. The Vector itself is left untouched
+*/
+func (v Vector[T]) Transient() *Transient[T] {
+	v.ensureInitialized()
+
+	tail := make([]T, len(v.trie.tail), width)
+	copy(tail, v.trie.tail)
+	v.trie.tail = tail
+
+	return &Transient[T]{
+		edit:   new(bool),
+		equals: v.equals,
+		trie:   v.trie,
+	}
+}
+
+// the zero value of Vector is an empty vector without a root
+func (v *Vector[T]) ensureInitialized() {
+	if v.trie.root == nil {
+		v.trie = emptyTrie[T]()
+	}
+}
+
+func (v Vector[T]) checkEqualityComparer() error {
+	if v.equals == nil {
+		return errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package persistentvector
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/list"
+	"github.com/golanglibs/gocollections/list/arraylist"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testReadOnlyLister[T any](l list.ReadOnlyLister[T]) {}
+
+func testReadOnlyCollectioner[T any](c generic.ReadOnlyCollectioner[T]) {}
+
+func toSlice[T any](v Vector[T]) []T {
+	elements := make([]T, 0, v.Size())
+	v.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return elements
+}
+
+func sequence(start int, end int) []int {
+	elements := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		elements = append(elements, i)
+	}
+
+	return elements
+}
+
+func Test_VectorShouldImplementReadOnlyListerAndReadOnlyCollectioner(t *testing.T) {
+	vector := New[int]()
+
+	testReadOnlyLister[int](vector)
+	testReadOnlyCollectioner[int](vector)
+}
+
+func Test_New(t *testing.T) {
+	vector := New(10, 16, 5)
+
+	goassert.Equal(t, 3, vector.Size())
+	goassert.False(t, vector.Empty())
+	goassert.DeepEqual(t, []int{10, 16, 5}, toSlice(vector))
+	goassert.True(t, vector.Contains(16))
+}
+
+func Test_NewOfAny(t *testing.T) {
+	vector := NewOfAny([]int{1}, []int{2})
+
+	goassert.Equal(t, 2, vector.Size())
+	goassert.Equal(t, 2, (*vector.At(1))[0])
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot compute equality of elements since equality comparer is not set",
+		func() {
+			vector.Contains([]int{1})
+		},
+	)
+}
+
+func Test_ZeroValueVectorShouldBeEmpty(t *testing.T) {
+	var vector Vector[int]
+
+	goassert.True(t, vector.Empty())
+	goassert.Equal(t, 0, vector.Size())
+
+	appended := vector.Append(10)
+
+	goassert.True(t, vector.Empty())
+	goassert.DeepEqual(t, []int{10}, toSlice(appended))
+}
+
+func Test_VectorAppendShouldReturnNewVersion_AndLeaveOldVersionUntouched(t *testing.T) {
+	versions := []Vector[int]{New[int]()}
+	for i := 0; i < 2000; i++ {
+		versions = append(versions, versions[i].Append(i))
+	}
+
+	for size, version := range versions {
+		goassert.Equal(t, size, version.Size())
+		goassert.DeepEqual(t, sequence(0, size), toSlice(version))
+	}
+}
+
+func Test_VectorAtShouldReturnElementsAcrossTrieLevels(t *testing.T) {
+	vector := New(sequence(0, 40000)...)
+
+	for _, index := range []int{0, 31, 32, 1023, 1024, 1055, 1056, 32767, 32768, 39999} {
+		goassert.Equal(t, index, *vector.At(index))
+	}
+	goassert.Equal(t, 0, *vector.Front())
+	goassert.Equal(t, 39999, *vector.Back())
+}
+
+func Test_VectorAtShouldReturnReferenceToCopy(t *testing.T) {
+	vector := New(10, 16, 5)
+
+	*vector.At(0) = 1
+	*vector.Front() = 1
+	*vector.Back() = 1
+	vector.ForEach(func(element *int) {
+		*element = 1
+	})
+
+	goassert.DeepEqual(t, []int{10, 16, 5}, toSlice(vector))
+}
+
+func Test_VectorAtShouldPanic_GivenIndexIsOutOfRange(t *testing.T) {
+	vector := New(10, 16, 5)
+
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrIndexOutOfRange,
+		"Vector.At could not retrieve element because given index 3 is out of range",
+		func() {
+			vector.At(3)
+		},
+	)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrIndexOutOfRange,
+		"Vector.At could not retrieve element because given index -1 is out of range",
+		func() {
+			vector.At(-1)
+		},
+	)
+}
+
+func Test_VectorSetShouldReturnNewVersion_AndLeaveOldVersionUntouched(t *testing.T) {
+	original := New(sequence(0, 2000)...)
+
+	updated := original
+	for i := 0; i < 2000; i += 7 {
+		updated = updated.Set(i, -i)
+	}
+
+	for i := 0; i < 2000; i++ {
+		goassert.Equal(t, i, *original.At(i))
+		if i%7 == 0 {
+			goassert.Equal(t, -i, *updated.At(i))
+		} else {
+			goassert.Equal(t, i, *updated.At(i))
+		}
+	}
+}
+
+func Test_VectorTrySetShouldReturnError_GivenIndexIsOutOfRange(t *testing.T) {
+	vector := New(10, 16, 5)
+
+	_, err := vector.TrySet(3, 1)
+
+	testhelpers.ErrorIs(t, errors.ErrIndexOutOfRange, err)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrIndexOutOfRange,
+		"Vector.Set could not set given value because given index 3 is out of range",
+		func() {
+			vector.Set(3, 1)
+		},
+	)
+}
+
+func Test_VectorPopShouldReturnNewVersion_AndLeaveOldVersionUntouched(t *testing.T) {
+	versions := []Vector[int]{New(sequence(0, 2000)...)}
+	for i := 0; i < 2000; i++ {
+		versions = append(versions, versions[i].Pop())
+	}
+
+	for i, version := range versions {
+		size := 2000 - i
+		goassert.Equal(t, size, version.Size())
+		goassert.DeepEqual(t, sequence(0, size), toSlice(version))
+	}
+}
+
+func Test_VectorAppendAfterPopShouldNotAffectOtherVersions(t *testing.T) {
+	original := New(sequence(0, 1057)...)
+
+	popped := original.Pop().Pop()
+	first := popped.Append(-1)
+	second := popped.Append(-2)
+
+	goassert.DeepEqual(t, sequence(0, 1057), toSlice(original))
+	goassert.Equal(t, -1, *first.Back())
+	goassert.Equal(t, -2, *second.Back())
+	goassert.Equal(t, 1054, *first.At(1054))
+}
+
+func Test_VectorPopShouldPanic_GivenVectorIsEmpty(t *testing.T) {
+	vector := New[int]()
+
+	_, err := vector.TryPop()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Vector.Pop failed because the vector is empty", func() {
+		vector.Pop()
+	})
+}
+
+func Test_VectorSlice(t *testing.T) {
+	vector := New(sequence(0, 2000)...)
+
+	ranges := [][2]int{{0, 0}, {0, 2000}, {5, 20}, {31, 33}, {100, 1024}, {1000, 1057}, {1999, 2000}, {2000, 2000}}
+	for _, r := range ranges {
+		slice := vector.Slice(r[0], r[1])
+
+		goassert.Equal(t, r[1]-r[0], slice.Size())
+		goassert.DeepEqual(t, sequence(r[0], r[1]), toSlice(slice))
+	}
+	goassert.DeepEqual(t, sequence(0, 2000), toSlice(vector))
+}
+
+func Test_VectorSliceShouldSupportFurtherVersions(t *testing.T) {
+	vector := New(sequence(0, 2000)...)
+
+	slice := vector.Slice(40, 1100)
+	appended := slice.Append(-1).Set(0, -2)
+	popped := slice.Pop()
+	sliceOfSlice := slice.Slice(10, 20)
+
+	goassert.Equal(t, 1061, appended.Size())
+	goassert.Equal(t, -2, *appended.Front())
+	goassert.Equal(t, -1, *appended.Back())
+	goassert.Equal(t, 41, *appended.At(1))
+	goassert.DeepEqual(t, sequence(40, 1099), toSlice(popped))
+	goassert.DeepEqual(t, sequence(50, 60), toSlice(sliceOfSlice))
+	goassert.DeepEqual(t, sequence(40, 1100), toSlice(slice))
+	goassert.DeepEqual(t, sequence(0, 2000), toSlice(vector))
+}
+
+func Test_VectorSliceShouldPanic_GivenRangeIsInvalid(t *testing.T) {
+	vector := New(10, 16, 5)
+
+	_, err := vector.TrySlice(2, 1)
+
+	testhelpers.ErrorIs(t, errors.ErrInvalidRange, err)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrInvalidRange,
+		"Vector.Slice cannot create a slice because invalid range [0, 4) was given",
+		func() {
+			vector.Slice(0, 4)
+		},
+	)
+}
+
+func Test_VectorIndexOfAndContains(t *testing.T) {
+	vector := New(10, 16, 5, 16)
+
+	goassert.Equal(t, 1, vector.IndexOf(16))
+	goassert.Equal(t, -1, vector.IndexOf(1))
+	goassert.True(t, vector.Contains(5))
+	goassert.False(t, vector.Contains(1))
+	goassert.Equal(t, 1, vector.Slice(2, 4).IndexOf(16))
+}
+
+func Test_VectorWithEqualityComparer(t *testing.T) {
+	vector := NewOfAny([]int{1}, []int{2}).WithEqualityComparer(func(a *[]int, b *[]int) bool {
+		return (*a)[0] == (*b)[0]
+	})
+
+	goassert.True(t, vector.Contains([]int{2}))
+	goassert.Equal(t, 1, vector.IndexOf([]int{2}))
+}
+
+func Test_VectorEqualsAndHashCode(t *testing.T) {
+	vector := New(10, 16, 5)
+	l := arraylist.New(10, 16, 5)
+
+	goassert.True(t, vector.Equals(&l))
+	goassert.True(t, vector.Equals(New(1, 10, 16, 5).Slice(1, 4)))
+	goassert.False(t, vector.Equals(New(10, 5, 16)))
+	goassert.Equal(t, l.HashCode(comparer.DefaultHash[int]), vector.HashCode(comparer.DefaultHash[int]))
+}
+
+func Test_VectorFromAndToArrayList(t *testing.T) {
+	l := arraylist.New(sequence(0, 100)...)
+
+	vector := NewFromCollection[int](&l)
+	converted := vector.Append(100).ToArrayList()
+
+	goassert.Equal(t, 100, vector.Size())
+	goassert.Equal(t, 101, converted.Size())
+	goassert.Equal(t, 100, *converted.Back())
+	goassert.Equal(t, 50, converted.IndexOf(50))
+	goassert.True(t, vector.Equals(&l))
+}
+
+func Test_NewOfAnyFromCollection(t *testing.T) {
+	l := arraylist.NewOfAny([]int{1}, []int{2})
+
+	vector := NewOfAnyFromCollection[[]int](&l)
+
+	goassert.Equal(t, 2, vector.Size())
+	goassert.Equal(t, 1, (*vector.Front())[0])
+}
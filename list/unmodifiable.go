@@ -0,0 +1,318 @@
+package list
+
+import (
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+)
+
+/*
+Wrapper which gives read-only access to a Lister. Every modifying method panics, and every modifying Try method
+returns, an error wrapping errors.ErrUnmodifiable. Elements are handed out as references to copies, so callers
+cannot modify the wrapped list through the returned pointers either.
+The wrapper does not copy the wrapped list: changes made through the original Lister are visible through
+the wrapper. Use Freeze on the list to get a snapshot instead.
+Implements Lister and Collectioner.
+UnmodifiableList is as thread safe as the wrapped list
+*/
+type UnmodifiableList[T any] struct {
+	list Lister[T]
+}
+
+/*
+Wraps the given Lister into an UnmodifiableList and returns it
+*/
+func Unmodifiable[T any](list Lister[T]) UnmodifiableList[T] {
+	return UnmodifiableList[T]{
+		list: list,
+	}
+}
+
+func unmodifiableError(method string) error {
+	return errors.Newf(errors.ErrUnmodifiable, "UnmodifiableList.%s failed because the list is unmodifiable", method)
+}
+
+func copyOf[T any](element *T) *T {
+	copied := *element
+	return &copied
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.SetEqualityComparer
+*/
+func (u *UnmodifiableList[T]) SetEqualityComparer(equals func(*T, *T) bool) {
+	panic(unmodifiableError("SetEqualityComparer"))
+}
+
+/*
+Returns a reference to a copy of the element at the given index. Panics if the given index is out of range.
+Implements Lister.At
+*/
+func (u *UnmodifiableList[T]) At(index int) *T {
+	return copyOf(u.list.At(index))
+}
+
+/*
+Returns a reference to a copy of the element at the given index. Returns an error wrapping
+errors.ErrIndexOutOfRange if the given index is out of range.
+Implements Lister.TryAt
+*/
+func (u *UnmodifiableList[T]) TryAt(index int) (*T, error) {
+	element, err := u.list.TryAt(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyOf(element), nil
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.Set
+*/
+func (u *UnmodifiableList[T]) Set(index int, value T) {
+	panic(unmodifiableError("Set"))
+}
+
+/*
+Returns an error wrapping errors.ErrUnmodifiable because the list is unmodifiable.
+Implements Lister.TrySet
+*/
+func (u *UnmodifiableList[T]) TrySet(index int, value T) error {
+	return unmodifiableError("Set")
+}
+
+/*
+Returns the number of elements in the wrapped list.
+Implements Lister.Size and Collectioner.Size
+*/
+func (u *UnmodifiableList[T]) Size() int {
+	return u.list.Size()
+}
+
+/*
+Returns true if the wrapped list is empty.
+Implements Lister.Empty and Collectioner.Empty
+*/
+func (u *UnmodifiableList[T]) Empty() bool {
+	return u.list.Empty()
+}
+
+/*
+Returns a reference to a copy of the first element. Panics if the list is empty.
+Implements Lister.Front
+*/
+func (u *UnmodifiableList[T]) Front() *T {
+	return copyOf(u.list.Front())
+}
+
+/*
+Returns a reference to a copy of the first element. Returns an error wrapping errors.ErrEmpty if the list is
+empty.
+Implements Lister.TryFront
+*/
+func (u *UnmodifiableList[T]) TryFront() (*T, error) {
+	element, err := u.list.TryFront()
+	if err != nil {
+		return nil, err
+	}
+
+	return copyOf(element), nil
+}
+
+/*
+Returns a reference to a copy of the last element. Panics if the list is empty.
+Implements Lister.Back
+*/
+func (u *UnmodifiableList[T]) Back() *T {
+	return copyOf(u.list.Back())
+}
+
+/*
+Returns a reference to a copy of the last element. Returns an error wrapping errors.ErrEmpty if the list is
+empty.
+Implements Lister.TryBack
+*/
+func (u *UnmodifiableList[T]) TryBack() (*T, error) {
+	element, err := u.list.TryBack()
+	if err != nil {
+		return nil, err
+	}
+
+	return copyOf(element), nil
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.Add and Collectioner.Add
+*/
+func (u *UnmodifiableList[T]) Add(element T) bool {
+	panic(unmodifiableError("Add"))
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.RemoveBack
+*/
+func (u *UnmodifiableList[T]) RemoveBack() {
+	panic(unmodifiableError("RemoveBack"))
+}
+
+/*
+Returns an error wrapping errors.ErrUnmodifiable because the list is unmodifiable.
+Implements Lister.TryRemoveBack
+*/
+func (u *UnmodifiableList[T]) TryRemoveBack() error {
+	return unmodifiableError("RemoveBack")
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.Insert
+*/
+func (u *UnmodifiableList[T]) Insert(index int, value T) bool {
+	panic(unmodifiableError("Insert"))
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.AddToFront
+*/
+func (u *UnmodifiableList[T]) AddToFront(element T) {
+	panic(unmodifiableError("AddToFront"))
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.RemoveFront
+*/
+func (u *UnmodifiableList[T]) RemoveFront() {
+	panic(unmodifiableError("RemoveFront"))
+}
+
+/*
+Returns an error wrapping errors.ErrUnmodifiable because the list is unmodifiable.
+Implements Lister.TryRemoveFront
+*/
+func (u *UnmodifiableList[T]) TryRemoveFront() error {
+	return unmodifiableError("RemoveFront")
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.Remove and Collectioner.Remove
+*/
+func (u *UnmodifiableList[T]) Remove(element T) bool {
+	panic(unmodifiableError("Remove"))
+}
+
+/*
+Returns an error wrapping errors.ErrUnmodifiable because the list is unmodifiable.
+Implements Lister.TryRemove
+*/
+func (u *UnmodifiableList[T]) TryRemove(element T) (bool, error) {
+	return false, unmodifiableError("Remove")
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.RemoveAt
+*/
+func (u *UnmodifiableList[T]) RemoveAt(index int) {
+	panic(unmodifiableError("RemoveAt"))
+}
+
+/*
+Returns an error wrapping errors.ErrUnmodifiable because the list is unmodifiable.
+Implements Lister.TryRemoveAt
+*/
+func (u *UnmodifiableList[T]) TryRemoveAt(index int) error {
+	return unmodifiableError("RemoveAt")
+}
+
+/*
+Returns the index of the first occurrence of the given value in the wrapped list or -1 if it is not found.
+Implements Lister.IndexOf
+*/
+func (u *UnmodifiableList[T]) IndexOf(element T) int {
+	return u.list.IndexOf(element)
+}
+
+/*
+Returns the index of the first occurrence of the given value in the wrapped list or -1 if it is not found.
+Returns an error wrapping errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements Lister.TryIndexOf
+*/
+func (u *UnmodifiableList[T]) TryIndexOf(element T) (int, error) {
+	return u.list.TryIndexOf(element)
+}
+
+/*
+Returns true if the given value exists in the wrapped list.
+Implements Lister.Contains and Collectioner.Contains
+*/
+func (u *UnmodifiableList[T]) Contains(element T) bool {
+	return u.list.Contains(element)
+}
+
+/*
+Returns true if the given value exists in the wrapped list. Returns an error wrapping
+errors.ErrNoEqualityComparer if the equality comparer is not set.
+Implements Lister.TryContains
+*/
+func (u *UnmodifiableList[T]) TryContains(element T) (bool, error) {
+	return u.list.TryContains(element)
+}
+
+/*
+Returns a new, modifiable copy of the elements of the wrapped list from "start" index (inclusive) to "end"
+index (exclusive). Panics if the given range is invalid.
+Implements Lister.SubList
+*/
+func (u *UnmodifiableList[T]) SubList(start int, end int) Lister[T] {
+	return u.list.SubList(start, end)
+}
+
+/*
+Returns a new, modifiable copy of the elements of the wrapped list from "start" index (inclusive) to "end"
+index (exclusive). Returns an error wrapping errors.ErrInvalidRange if the given range is invalid.
+Implements Lister.TrySubList
+*/
+func (u *UnmodifiableList[T]) TrySubList(start int, end int) (Lister[T], error) {
+	return u.list.TrySubList(start, end)
+}
+
+/*
+Returns true if the given list has the same size as the wrapped list and equal elements in the same order.
+Implements Lister.Equals
+*/
+func (u *UnmodifiableList[T]) Equals(other ReadOnlyLister[T]) bool {
+	return u.list.Equals(other)
+}
+
+/*
+Returns a hash code which depends on the elements of the wrapped list and their order.
+Implements Lister.HashCode
+*/
+func (u *UnmodifiableList[T]) HashCode(hash func(*T) uint64) uint64 {
+	return generic.OrderedHashCode[T](u, hash)
+}
+
+/*
+Panics because the list is unmodifiable.
+Implements Lister.Clear
+*/
+func (u *UnmodifiableList[T]) Clear() {
+	panic(unmodifiableError("Clear"))
+}
+
+/*
+Iterates through each element of the wrapped list and executes the given function on a reference to a copy of
+the element.
+Implements Lister.ForEach and Collectioner.ForEach
+*/
+func (u *UnmodifiableList[T]) ForEach(do func(*T)) {
+	u.list.ForEach(func(element *T) {
+		do(copyOf(element))
+	})
+}
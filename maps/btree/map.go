@@ -0,0 +1,555 @@
+package btree
+
+import (
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+)
+
+const defaultDegree = 32
+
+/*
+Configures a Map created through New or NewWithComparator
+*/
+type Option func(*options)
+
+type options struct {
+	degree int
+}
+
+/*
+Sets the minimum degree of the B-tree: every node except the root holds between degree-1 and 2*degree-1 keys.
+Larger degrees make the tree shallower and keep more keys in each contiguous node, which is friendlier to the
+cache, at the cost of moving more keys when a node changes. The default degree is 32. Panics with an error
+wrapping errors.ErrInvalidArgument if the given degree is less than 2
+*/
+func Degree(degree int) Option {
+	if degree < 2 {
+		panic(errors.Newf(errors.ErrInvalidArgument, "Degree of a B-tree must be at least 2 but %d was given", degree))
+	}
+
+	return func(o *options) {
+		o.degree = degree
+	}
+}
+
+/*
+Key and value pair, used to bulk load a Map
+*/
+type Entry[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+/*
+Sorted map implemented as a B-tree, which stores many keys in each node and therefore has a much better cache
+locality than binary search trees, especially for large maps. Keys are kept in the order of the given
+Comparator. Put, Get and Remove take O(log n) time.
+Clone returns a snapshot in O(1) time: the two Maps share all nodes and copy a node only when they modify it
+(copy-on-write), so a snapshot costs memory proportional to the changes made after it was taken.
+Since nodes may be shared, ForEach and the range scans hand out references to copies of the values.
+Implements Mapper and ReadOnlyMapper.
+Map is not thread safe, but a Clone may be used by another goroutine than the Map it was cloned from
+*/
+type Map[K any, V any] struct {
+	compare comparer.Comparator[K]
+	degree  int
+	root    *node[K, V]
+	size    int
+	cow     *cowToken
+}
+
+/*
+Creates a new instance of empty Map which orders the keys in their natural ascending order and returns it.
+Options such as Degree can be given to configure the Map
+*/
+func New[K comparer.Ordered, V any](opts ...Option) Map[K, V] {
+	return NewWithComparator[K, V](comparer.Natural[K](), opts...)
+}
+
+/*
+Creates a new instance of empty Map which orders the keys with the given Comparator and returns it. Keys the
+Comparator orders equally are considered the same key. Options such as Degree can be given to configure the Map
+*/
+func NewWithComparator[K any, V any](compare comparer.Comparator[K], opts ...Option) Map[K, V] {
+	o := options{degree: defaultDegree}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return Map[K, V]{
+		compare: compare,
+		degree:  o.degree,
+		cow:     &cowToken{},
+	}
+}
+
+func (m *Map[K, V]) maxItems() int {
+	return 2*m.degree - 1
+}
+
+func (m *Map[K, V]) minItems() int {
+	return m.degree - 1
+}
+
+/*
+Returns the number of keys in the Map.
+Implements ReadOnlyMapper.Size
+*/
+func (m *Map[K, V]) Size() int {
+	return m.size
+}
+
+/*
+Returns true if the Map is empty. Otherwise, false.
+Implements ReadOnlyMapper.Empty
+*/
+func (m *Map[K, V]) Empty() bool {
+	return m.size == 0
+}
+
+/*
+Returns the value associated with the given key and true, or the zero value and false if the key does not
+exist. Time complexity is O(log n).
+Implements ReadOnlyMapper.Get
+*/
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	for n := m.root; n != nil; {
+		i, found := n.find(&key, m.compare)
+		if found {
+			return n.items[i].value, true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+
+	var value V
+	return value, false
+}
+
+/*
+Returns true if the given key exists in the Map. Otherwise, false.
+Implements ReadOnlyMapper.ContainsKey
+*/
+func (m *Map[K, V]) ContainsKey(key K) bool {
+	_, found := m.Get(key)
+	return found
+}
+
+/*
+Associates the given value with the given key. Returns true if the key was added and false if the value of an
+existing key was replaced. Full nodes on the way down are split before they are entered, so the tree is only
+walked once. Time complexity is O(log n).
+Implements Mapper.Put
+*/
+func (m *Map[K, V]) Put(key K, value V) bool {
+	it := item[K, V]{key: key, value: value}
+	if m.root == nil {
+		m.root = &node[K, V]{cow: m.cow}
+	}
+
+	m.root = m.root.mutableFor(m.cow)
+	if len(m.root.items) >= m.maxItems() {
+		middle, next := m.root.split(m.maxItems() / 2)
+		m.root = &node[K, V]{
+			items:    []item[K, V]{middle},
+			children: []*node[K, V]{m.root, next},
+			cow:      m.cow,
+		}
+	}
+
+	added := m.insert(m.root, it)
+	if added {
+		m.size++
+	}
+
+	return added
+}
+
+func (m *Map[K, V]) insert(n *node[K, V], it item[K, V]) bool {
+	for {
+		i, found := n.find(&it.key, m.compare)
+		if found {
+			n.items[i].value = it.value
+			return false
+		}
+		if n.leaf() {
+			insertItem(&n.items, i, it)
+			return true
+		}
+
+		if len(n.children[i].items) >= m.maxItems() {
+			middle, next := n.mutableChild(i).split(m.maxItems() / 2)
+			insertItem(&n.items, i, middle)
+			insertChild(&n.children, i+1, next)
+
+			switch order := m.compare(&it.key, &middle.key); {
+			case order == 0:
+				n.items[i].value = it.value
+				return false
+			case order > 0:
+				i++
+			}
+		}
+
+		n = n.mutableChild(i)
+	}
+}
+
+/*
+Removes the given key and its value. Returns true if the key was found and removed. Otherwise, false. Nodes with
+the minimum number of keys on the way down are grown before they are entered, so the tree is only walked once.
+Time complexity is O(log n).
+Implements Mapper.Remove
+*/
+func (m *Map[K, V]) Remove(key K) bool {
+	if m.root == nil {
+		return false
+	}
+
+	m.root = m.root.mutableFor(m.cow)
+	removed := m.remove(m.root, &key)
+	if len(m.root.items) == 0 && !m.root.leaf() {
+		m.root = m.root.children[0]
+	}
+	if removed {
+		m.size--
+	}
+
+	return removed
+}
+
+func (m *Map[K, V]) remove(n *node[K, V], key *K) bool {
+	for {
+		i, found := n.find(key, m.compare)
+		if n.leaf() {
+			if found {
+				removeItem(&n.items, i)
+			}
+			return found
+		}
+
+		if len(n.children[i].items) <= m.minItems() {
+			// growing the child moves items around, so the node is searched again
+			m.growChild(n, i)
+			continue
+		}
+
+		child := n.mutableChild(i)
+		if found {
+			// the predecessor, the largest key of the left child, takes the place of the removed key
+			n.items[i] = m.removeMax(child)
+			return true
+		}
+		n = child
+	}
+}
+
+func (m *Map[K, V]) removeMax(n *node[K, V]) item[K, V] {
+	for !n.leaf() {
+		last := len(n.children) - 1
+		if len(n.children[last].items) <= m.minItems() {
+			m.growChild(n, last)
+			continue
+		}
+		n = n.mutableChild(last)
+	}
+
+	return removeItem(&n.items, len(n.items)-1)
+}
+
+/*
+Makes sure the child at the given index holds more than the minimum number of items by moving an item from a
+sibling through the node, or by merging the child with a sibling and the item between them
+*/
+func (m *Map[K, V]) growChild(n *node[K, V], i int) {
+	if i > 0 && len(n.children[i-1].items) > m.minItems() {
+		child := n.mutableChild(i)
+		left := n.mutableChild(i - 1)
+
+		insertItem(&child.items, 0, n.items[i-1])
+		n.items[i-1] = removeItem(&left.items, len(left.items)-1)
+		if !left.leaf() {
+			insertChild(&child.children, 0, removeChild(&left.children, len(left.children)-1))
+		}
+		return
+	}
+
+	if i < len(n.items) && len(n.children[i+1].items) > m.minItems() {
+		child := n.mutableChild(i)
+		right := n.mutableChild(i + 1)
+
+		child.items = append(child.items, n.items[i])
+		n.items[i] = removeItem(&right.items, 0)
+		if !right.leaf() {
+			child.children = append(child.children, removeChild(&right.children, 0))
+		}
+		return
+	}
+
+	if i >= len(n.items) {
+		i--
+	}
+	child := n.mutableChild(i)
+	merged := removeChild(&n.children, i+1)
+	child.items = append(child.items, removeItem(&n.items, i))
+	child.items = append(child.items, merged.items...)
+	child.children = append(child.children, merged.children...)
+}
+
+/*
+Removes all keys from the Map. Snapshots taken through Clone are not affected.
+Implements Mapper.Clear
+*/
+func (m *Map[K, V]) Clear() {
+	m.root = nil
+	m.size = 0
+}
+
+/*
+Returns a snapshot of the Map in O(1) time. The Map and the snapshot share all nodes and copy the nodes they
+modify afterwards, so neither of them observes the changes of the other
+*/
+func (m *Map[K, V]) Clone() Map[K, V] {
+	m.cow = &cowToken{}
+	clone := *m
+	clone.cow = &cowToken{}
+
+	return clone
+}
+
+/*
+Returns the smallest key and its value. Panics if the Map is empty
+*/
+func (m *Map[K, V]) Min() (K, V) {
+	key, value, err := m.TryMin()
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+/*
+Returns the smallest key and its value. Returns an error wrapping errors.ErrEmpty if the Map is empty
+*/
+func (m *Map[K, V]) TryMin() (K, V, error) {
+	if m.Empty() {
+		var key K
+		var value V
+		return key, value, errors.Newf(errors.ErrEmpty, "Cannot get Min. Map is empty")
+	}
+
+	n := m.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+
+	return n.items[0].key, n.items[0].value, nil
+}
+
+/*
+Returns the largest key and its value. Panics if the Map is empty
+*/
+func (m *Map[K, V]) Max() (K, V) {
+	key, value, err := m.TryMax()
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+/*
+Returns the largest key and its value. Returns an error wrapping errors.ErrEmpty if the Map is empty
+*/
+func (m *Map[K, V]) TryMax() (K, V, error) {
+	if m.Empty() {
+		var key K
+		var value V
+		return key, value, errors.Newf(errors.ErrEmpty, "Cannot get Max. Map is empty")
+	}
+
+	n := m.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	last := n.items[len(n.items)-1]
+
+	return last.key, last.value, nil
+}
+
+/*
+Iterates through the keys from "from" (inclusive) to "to" (exclusive) in ascending order and executes the given
+function on a reference to a copy of each key and its value. The Map must not be modified during the iteration
+*/
+func (m *Map[K, V]) Range(from K, to K, do func(*K, *V)) {
+	m.ascend(m.root, &from, &to, do)
+}
+
+/*
+Iterates through the keys from "from" (inclusive) to "to" (exclusive) in descending order, starting with the
+largest key less than "to", and executes the given function on a reference to a copy of each key and its value.
+The Map must not be modified during the iteration
+*/
+func (m *Map[K, V]) RangeDescending(from K, to K, do func(*K, *V)) {
+	m.descend(m.root, &from, &to, do)
+}
+
+/*
+Iterates through the keys in ascending order and executes the given function on a reference to a copy of each
+key and its value. The Map must not be modified during the iteration.
+Implements ReadOnlyMapper.ForEach
+*/
+func (m *Map[K, V]) ForEach(do func(*K, *V)) {
+	m.ascend(m.root, nil, nil, do)
+}
+
+/*
+Iterates through the keys in descending order and executes the given function on a reference to a copy of each
+key and its value. The Map must not be modified during the iteration
+*/
+func (m *Map[K, V]) ForEachDescending(do func(*K, *V)) {
+	m.descend(m.root, nil, nil, do)
+}
+
+// visits the items of the subtree in [from, to) in ascending order. Returns false once a key reaches "to"
+func (m *Map[K, V]) ascend(n *node[K, V], from *K, to *K, do func(*K, *V)) bool {
+	if n == nil {
+		return true
+	}
+
+	i := 0
+	if from != nil {
+		i, _ = n.find(from, m.compare)
+	}
+	for ; i < len(n.items); i++ {
+		if !n.leaf() && !m.ascend(n.children[i], from, to, do) {
+			return false
+		}
+		if to != nil && m.compare(&n.items[i].key, to) >= 0 {
+			return false
+		}
+		visit(n.items[i], do)
+	}
+
+	return n.leaf() || m.ascend(n.children[len(n.items)], from, to, do)
+}
+
+// visits the items of the subtree in [from, to) in descending order. Returns false once a key is below "from"
+func (m *Map[K, V]) descend(n *node[K, V], from *K, to *K, do func(*K, *V)) bool {
+	if n == nil {
+		return true
+	}
+
+	i := len(n.items)
+	if to != nil {
+		i, _ = n.find(to, m.compare)
+	}
+	if !n.leaf() && !m.descend(n.children[i], from, to, do) {
+		return false
+	}
+	for i--; i >= 0; i-- {
+		if from != nil && m.compare(&n.items[i].key, from) < 0 {
+			return false
+		}
+		visit(n.items[i], do)
+		if !n.leaf() && !m.descend(n.children[i], from, to, do) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func visit[K any, V any](it item[K, V], do func(*K, *V)) {
+	do(&it.key, &it.value)
+}
+
+/*
+Replaces the contents of the Map with the given entries, whose keys must be strictly ascending in the order of
+the Comparator. The tree is built bottom-up with nearly full nodes in O(n) time, which is much faster than
+putting the entries one by one. Panics if the keys are not strictly ascending
+*/
+func (m *Map[K, V]) BulkLoad(entries []Entry[K, V]) {
+	if err := m.TryBulkLoad(entries); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Replaces the contents of the Map with the given entries, whose keys must be strictly ascending in the order of
+the Comparator, in O(n) time. Returns an error wrapping errors.ErrNotSorted and leaves the Map untouched if the
+keys are not strictly ascending
+*/
+func (m *Map[K, V]) TryBulkLoad(entries []Entry[K, V]) error {
+	for i := 1; i < len(entries); i++ {
+		if m.compare(&entries[i-1].Key, &entries[i].Key) >= 0 {
+			return errors.Newf(
+				errors.ErrNotSorted,
+				"Map.BulkLoad failed because the key at index %d is not greater than the key before it",
+				i,
+			)
+		}
+	}
+
+	items := make([]item[K, V], len(entries))
+	for i, entry := range entries {
+		items[i] = item[K, V]{key: entry.Key, value: entry.Value}
+	}
+
+	m.root = m.build(items)
+	m.size = len(entries)
+
+	return nil
+}
+
+/*
+Builds the tree level by level. The items of a level are split into groups separated by single items which move
+up to the next level, where every group has between degree-1 and 2*degree-1 items. Using ceil((n+1)/(2*degree))
+groups guarantees both bounds
+*/
+func (m *Map[K, V]) build(items []item[K, V]) *node[K, V] {
+	if len(items) == 0 {
+		return nil
+	}
+
+	var children []*node[K, V]
+	for {
+		count := len(items)
+		if count <= m.maxItems() {
+			return &node[K, V]{items: items, children: children, cow: m.cow}
+		}
+
+		groups := (count + 2*m.degree) / (2 * m.degree)
+		grouped := count - (groups - 1)
+		base, extra := grouped/groups, grouped%groups
+
+		nodes := make([]*node[K, V], 0, groups)
+		separators := make([]item[K, V], 0, groups-1)
+		position, childPosition := 0, 0
+		for group := 0; group < groups; group++ {
+			size := base
+			if group < extra {
+				size++
+			}
+
+			n := &node[K, V]{cow: m.cow}
+			n.items = append(make([]item[K, V], 0, m.maxItems()), items[position:position+size]...)
+			if children != nil {
+				n.children = append(make([]*node[K, V], 0, m.maxItems()+1), children[childPosition:childPosition+size+1]...)
+				childPosition += size + 1
+			}
+			nodes = append(nodes, n)
+			position += size
+
+			if group < groups-1 {
+				separators = append(separators, items[position])
+				position++
+			}
+		}
+
+		items, children = separators, nodes
+	}
+}
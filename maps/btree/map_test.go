@@ -0,0 +1,323 @@
+package btree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/maps"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testMapper[K any, V any](m maps.Mapper[K, V]) {}
+
+func keysOf[V any](m maps.ReadOnlyMapper[int, V]) []int {
+	keys := make([]int, 0, m.Size())
+	m.ForEach(func(key *int, _ *V) {
+		keys = append(keys, *key)
+	})
+
+	return keys
+}
+
+func sortedKeysOf(model map[int]int) []int {
+	keys := make([]int, 0, len(model))
+	for key := range model {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	return keys
+}
+
+// checks the key counts of the nodes, the order of the keys, that all leaves have the same depth and the size
+func checkTree[V any](t *testing.T, m *Map[int, V]) {
+	t.Helper()
+
+	if m.root == nil {
+		goassert.Equal(t, 0, m.size)
+		return
+	}
+
+	count := 0
+	leafDepth := -1
+	var walk func(n *node[int, V], depth int, low *int, high *int)
+	walk = func(n *node[int, V], depth int, low *int, high *int) {
+		if n != m.root {
+			goassert.True(t, len(n.items) >= m.minItems())
+		}
+		goassert.True(t, len(n.items) <= m.maxItems())
+		for i := range n.items {
+			key := n.items[i].key
+			goassert.True(t, low == nil || *low < key)
+			goassert.True(t, high == nil || key < *high)
+			goassert.True(t, i == 0 || n.items[i-1].key < key)
+		}
+		count += len(n.items)
+
+		if n.leaf() {
+			if leafDepth < 0 {
+				leafDepth = depth
+			}
+			goassert.Equal(t, leafDepth, depth)
+			return
+		}
+
+		goassert.Equal(t, len(n.items)+1, len(n.children))
+		for i, child := range n.children {
+			childLow, childHigh := low, high
+			if i > 0 {
+				childLow = &n.items[i-1].key
+			}
+			if i < len(n.items) {
+				childHigh = &n.items[i].key
+			}
+			walk(child, depth+1, childLow, childHigh)
+		}
+	}
+	walk(m.root, 0, nil, nil)
+
+	goassert.Equal(t, m.size, count)
+}
+
+func Test_MapShouldImplementMapper(t *testing.T) {
+	m := New[int, string]()
+
+	testMapper[int, string](&m)
+}
+
+func Test_MapPutGetAndRemove(t *testing.T) {
+	m := New[string, int]()
+
+	goassert.True(t, m.Put("b", 2))
+	goassert.True(t, m.Put("a", 1))
+	goassert.False(t, m.Put("b", 3))
+
+	b, bFound := m.Get("b")
+	_, cFound := m.Get("c")
+
+	goassert.Equal(t, 2, m.Size())
+	goassert.Equal(t, 3, b)
+	goassert.True(t, bFound)
+	goassert.False(t, cFound)
+	goassert.True(t, m.ContainsKey("a"))
+	goassert.True(t, m.Remove("a"))
+	goassert.False(t, m.Remove("a"))
+	goassert.False(t, m.ContainsKey("a"))
+	goassert.Equal(t, 1, m.Size())
+}
+
+func Test_MapShouldUseGivenComparator(t *testing.T) {
+	m := NewWithComparator[int, string](comparer.Natural[int]().Reverse(), Degree(2))
+	for key := 0; key < 10; key++ {
+		m.Put(key, "")
+	}
+
+	goassert.DeepEqual(t, []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, keysOf[string](&m))
+}
+
+func Test_DegreeShouldPanic_GivenDegreeLessThanTwo(t *testing.T) {
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrInvalidArgument,
+		"Degree of a B-tree must be at least 2 but 1 was given",
+		func() { Degree(1) },
+	)
+}
+
+func Test_MapForEachShouldPassCopiesOfValues(t *testing.T) {
+	m := New[int, int](Degree(2))
+	for key := 0; key < 10; key++ {
+		m.Put(key, key)
+	}
+
+	m.ForEach(func(_ *int, value *int) {
+		*value = -1
+	})
+
+	value, _ := m.Get(5)
+	goassert.Equal(t, 5, value)
+}
+
+func Test_MapRangeShouldVisitKeysInHalfOpenRange(t *testing.T) {
+	m := New[int, string](Degree(2))
+	for key := 0; key < 40; key += 2 {
+		m.Put(key, "")
+	}
+
+	var ascending, descending []int
+	m.Range(5, 14, func(key *int, _ *string) {
+		ascending = append(ascending, *key)
+	})
+	m.RangeDescending(6, 14, func(key *int, _ *string) {
+		descending = append(descending, *key)
+	})
+
+	goassert.DeepEqual(t, []int{6, 8, 10, 12}, ascending)
+	goassert.DeepEqual(t, []int{12, 10, 8, 6}, descending)
+}
+
+func Test_MapForEachDescendingShouldIterateInReverseKeyOrder(t *testing.T) {
+	m := New[int, string](Degree(2))
+	for _, key := range []int{14, 16, 5, 23, 7, 1, 30, 11} {
+		m.Put(key, "")
+	}
+
+	var keys []int
+	m.ForEachDescending(func(key *int, _ *string) {
+		keys = append(keys, *key)
+	})
+
+	goassert.DeepEqual(t, []int{30, 23, 16, 14, 11, 7, 5, 1}, keys)
+}
+
+func Test_MapMinAndMax(t *testing.T) {
+	m := New[int, string](Degree(2))
+	for _, key := range []int{14, 16, 5, 23, 7, 1, 30, 11} {
+		m.Put(key, "")
+	}
+
+	minKey, _ := m.Min()
+	maxKey, _ := m.Max()
+
+	goassert.Equal(t, 1, minKey)
+	goassert.Equal(t, 30, maxKey)
+}
+
+func Test_MapMinAndMaxShouldPanic_GivenEmptyMap(t *testing.T) {
+	m := New[int, string]()
+
+	_, _, err := m.TryMax()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot get Min. Map is empty", func() {
+		m.Min()
+	})
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot get Max. Map is empty", func() {
+		m.Max()
+	})
+}
+
+func Test_MapClear(t *testing.T) {
+	m := New[int, string](Degree(2))
+	for key := 0; key < 100; key++ {
+		m.Put(key, "")
+	}
+
+	m.Clear()
+	m.Put(1, "")
+
+	goassert.Equal(t, 1, m.Size())
+	goassert.DeepEqual(t, []int{1}, keysOf[string](&m))
+}
+
+func Test_MapCloneShouldBeIndependentOfOriginal(t *testing.T) {
+	m := New[int, int](Degree(2))
+	for key := 0; key < 100; key++ {
+		m.Put(key, key)
+	}
+
+	clone := m.Clone()
+	for key := 0; key < 100; key += 2 {
+		m.Remove(key)
+		clone.Put(key, -key)
+	}
+	clone.Put(100, 100)
+
+	first, _ := m.Get(1)
+	cloned, _ := clone.Get(2)
+
+	goassert.Equal(t, 50, m.Size())
+	goassert.Equal(t, 101, clone.Size())
+	goassert.Equal(t, 1, first)
+	goassert.Equal(t, -2, cloned)
+	goassert.False(t, m.ContainsKey(2))
+	checkTree[int](t, &m)
+	checkTree[int](t, &clone)
+}
+
+func Test_MapBulkLoadShouldBuildValidTree(t *testing.T) {
+	for _, degree := range []int{2, 3, 32} {
+		for _, size := range []int{0, 1, 3, 4, 7, 8, 100, 1000} {
+			m := New[int, int](Degree(degree))
+			m.Put(-1, -1)
+			entries := make([]Entry[int, int], size)
+			for i := range entries {
+				entries[i] = Entry[int, int]{Key: i, Value: i * 10}
+			}
+
+			m.BulkLoad(entries)
+			m.Put(size, size*10)
+
+			checkTree[int](t, &m)
+			goassert.Equal(t, size+1, m.Size())
+			goassert.False(t, m.ContainsKey(-1))
+			for i := 0; i <= size; i++ {
+				value, found := m.Get(i)
+				goassert.True(t, found)
+				goassert.Equal(t, i*10, value)
+			}
+		}
+	}
+}
+
+func Test_MapBulkLoadShouldPanic_GivenUnsortedEntries(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "")
+	entries := []Entry[int, string]{{Key: 1}, {Key: 3}, {Key: 3}}
+
+	err := m.TryBulkLoad(entries)
+
+	testhelpers.ErrorIs(t, errors.ErrNotSorted, err)
+	goassert.DeepEqual(t, []int{1}, keysOf[string](&m))
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNotSorted,
+		"Map.BulkLoad failed because the key at index 2 is not greater than the key before it",
+		func() { m.BulkLoad(entries) },
+	)
+}
+
+func Test_MapShouldMatchSortedModel_GivenRandomOperations(t *testing.T) {
+	for _, degree := range []int{2, 3, 5} {
+		random := rand.New(rand.NewSource(int64(degree)))
+		m := New[int, int](Degree(degree))
+		model := make(map[int]int)
+		var snapshot Map[int, int]
+		var snapshotKeys []int
+
+		for i := 0; i < 20000; i++ {
+			key := random.Intn(2000)
+			switch random.Intn(3) {
+			case 0:
+				_, exists := model[key]
+				goassert.Equal(t, exists, m.Remove(key))
+				delete(model, key)
+			default:
+				_, exists := model[key]
+				goassert.Equal(t, !exists, m.Put(key, i))
+				model[key] = i
+			}
+
+			if i%1000 == 0 {
+				keys := sortedKeysOf(model)
+
+				checkTree[int](t, &m)
+				goassert.DeepEqual(t, keys, keysOf[int](&m))
+				for _, key := range keys {
+					value, _ := m.Get(key)
+					goassert.Equal(t, model[key], value)
+				}
+
+				if snapshotKeys != nil {
+					checkTree[int](t, &snapshot)
+					goassert.DeepEqual(t, snapshotKeys, keysOf[int](&snapshot))
+				}
+				snapshot, snapshotKeys = m.Clone(), keys
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package btree
+
+import (
+	"sort"
+
+	"github.com/golanglibs/gocollections/comparer"
+)
+
+/*
+Identifies the Map which owns a node. Nodes may only be modified in place by the Map holding the same token,
+every other Map copies them first. Clone gives both Maps new tokens, so all existing nodes become shared
+*/
+type cowToken struct {
+	// a zero-sized token could share its address with other zero-sized values
+	_ byte
+}
+
+type item[K any, V any] struct {
+	key   K
+	value V
+}
+
+/*
+Node of the B-tree. A leaf has no children, and an internal node has exactly one child more than items, where
+the keys of children[i] are between the keys of items[i-1] and items[i]
+*/
+type node[K any, V any] struct {
+	items    []item[K, V]
+	children []*node[K, V]
+	cow      *cowToken
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+/*
+Returns the index of the first item whose key is greater than or equal to the given key and whether the key of
+that item equals the given key
+*/
+func (n *node[K, V]) find(key *K, compare comparer.Comparator[K]) (int, bool) {
+	i := sort.Search(len(n.items), func(i int) bool {
+		return compare(&n.items[i].key, key) >= 0
+	})
+
+	return i, i < len(n.items) && compare(&n.items[i].key, key) == 0
+}
+
+/*
+Returns the node itself if it belongs to the given token, or a copy belonging to the token otherwise
+*/
+func (n *node[K, V]) mutableFor(cow *cowToken) *node[K, V] {
+	if n.cow == cow {
+		return n
+	}
+
+	copied := &node[K, V]{
+		items: make([]item[K, V], len(n.items), cap(n.items)),
+		cow:   cow,
+	}
+	copy(copied.items, n.items)
+	if !n.leaf() {
+		copied.children = make([]*node[K, V], len(n.children), cap(n.children))
+		copy(copied.children, n.children)
+	}
+
+	return copied
+}
+
+/*
+Replaces the child at the given index with a copy the node's token may modify and returns it. The node itself
+must already be mutable
+*/
+func (n *node[K, V]) mutableChild(i int) *node[K, V] {
+	child := n.children[i].mutableFor(n.cow)
+	n.children[i] = child
+
+	return child
+}
+
+/*
+Splits the node at the given item index. The node keeps the items before the index, and the item at the index
+is returned along with a new node holding the items after it
+*/
+func (n *node[K, V]) split(i int) (item[K, V], *node[K, V]) {
+	middle := n.items[i]
+
+	next := &node[K, V]{cow: n.cow}
+	next.items = append(next.items, n.items[i+1:]...)
+	truncateItems(&n.items, i)
+	if !n.leaf() {
+		next.children = append(next.children, n.children[i+1:]...)
+		truncateChildren(&n.children, i+1)
+	}
+
+	return middle, next
+}
+
+func insertItem[K any, V any](items *[]item[K, V], i int, it item[K, V]) {
+	*items = append(*items, item[K, V]{})
+	copy((*items)[i+1:], (*items)[i:])
+	(*items)[i] = it
+}
+
+func removeItem[K any, V any](items *[]item[K, V], i int) item[K, V] {
+	removed := (*items)[i]
+	copy((*items)[i:], (*items)[i+1:])
+	truncateItems(items, len(*items)-1)
+
+	return removed
+}
+
+func insertChild[K any, V any](children *[]*node[K, V], i int, child *node[K, V]) {
+	*children = append(*children, nil)
+	copy((*children)[i+1:], (*children)[i:])
+	(*children)[i] = child
+}
+
+func removeChild[K any, V any](children *[]*node[K, V], i int) *node[K, V] {
+	removed := (*children)[i]
+	copy((*children)[i:], (*children)[i+1:])
+	truncateChildren(children, len(*children)-1)
+
+	return removed
+}
+
+// the removed tail is cleared so that the keys, values and nodes it referenced can be garbage collected
+func truncateItems[K any, V any](items *[]item[K, V], length int) {
+	var empty item[K, V]
+	for i := length; i < len(*items); i++ {
+		(*items)[i] = empty
+	}
+	*items = (*items)[:length]
+}
+
+func truncateChildren[K any, V any](children *[]*node[K, V], length int) {
+	for i := length; i < len(*children); i++ {
+		(*children)[i] = nil
+	}
+	*children = (*children)[:length]
+}
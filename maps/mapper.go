@@ -0,0 +1,40 @@
+package maps
+
+/*
+Read operations of map-like collections, which associate each key with exactly one value
+*/
+type ReadOnlyMapper[K any, V any] interface {
+	/* Returns the number of keys in the map */
+	Size() int
+
+	/* Returns true if the map is empty. Otherwise, false */
+	Empty() bool
+
+	/* Returns the value associated with the given key and true, or the zero value and false if it is absent */
+	Get(key K) (V, bool)
+
+	/* Returns true if the given key exists in the map. Otherwise, false */
+	ContainsKey(key K) bool
+
+	/* Iterates through each key and its value and executes the given function */
+	ForEach(do func(*K, *V))
+}
+
+/*
+Map-like collections which can be modified in place
+*/
+type Mapper[K any, V any] interface {
+	ReadOnlyMapper[K, V]
+
+	/*
+		Associates the given value with the given key. Returns true if the key was added and false if the value
+		of an existing key was replaced
+	*/
+	Put(key K, value V) bool
+
+	/* Removes the given key and its value. Returns true if the key was found and removed. Otherwise, false */
+	Remove(key K) bool
+
+	/* Empties the map */
+	Clear()
+}
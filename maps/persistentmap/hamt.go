@@ -0,0 +1,310 @@
+package persistentmap
+
+import "math/bits"
+
+const (
+	bitsPerLevel = 5
+	levelMask    = 1<<bitsPerLevel - 1
+	// nodes at this shift or deeper have run out of hash bits and hold keys whose hashes collide
+	collisionShift = 64
+)
+
+/*
+Entry of a node. An entry either holds a key with its value or, if "child" is set, a sub-node with all the keys
+whose hashes share the prefix leading to it
+*/
+type entry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+	child *node[K, V]
+}
+
+/*
+Node of the hash array mapped trie. Bit i of "bitmap" is set when the node has an entry for the 5 hash bits
+with value i, and "entries" holds those entries in order. Collision nodes do not use the bitmap and list their
+entries in insertion order. Nodes are never modified once they are reachable from a Map.
+A sub-node always holds at least two keys, so the shape of the trie only depends on its keys
+*/
+type node[K comparable, V any] struct {
+	bitmap  uint32
+	entries []entry[K, V]
+}
+
+func bitFor(hash uint64, shift uint) uint32 {
+	return 1 << ((hash >> shift) & levelMask)
+}
+
+func (n *node[K, V]) index(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *node[K, V]) find(hash uint64, key K) *entry[K, V] {
+	for shift := uint(0); ; shift += bitsPerLevel {
+		if shift >= collisionShift {
+			for i := range n.entries {
+				if n.entries[i].key == key {
+					return &n.entries[i]
+				}
+			}
+			return nil
+		}
+
+		bit := bitFor(hash, shift)
+		if n.bitmap&bit == 0 {
+			return nil
+		}
+
+		e := &n.entries[n.index(bit)]
+		if e.child == nil {
+			if e.hash == hash && e.key == key {
+				return e
+			}
+			return nil
+		}
+		n = e.child
+	}
+}
+
+/*
+Returns a copy of the node with the given entry inserted or replaced. The second result is true if the key
+was not present before
+*/
+func (n *node[K, V]) put(shift uint, e entry[K, V]) (*node[K, V], bool) {
+	if shift >= collisionShift {
+		for i := range n.entries {
+			if n.entries[i].key == e.key {
+				return n.withEntry(i, e), false
+			}
+		}
+
+		entries := make([]entry[K, V], len(n.entries), len(n.entries)+1)
+		copy(entries, n.entries)
+		return &node[K, V]{entries: append(entries, e)}, true
+	}
+
+	bit := bitFor(e.hash, shift)
+	i := n.index(bit)
+	if n.bitmap&bit == 0 {
+		entries := make([]entry[K, V], len(n.entries)+1)
+		copy(entries, n.entries[:i])
+		entries[i] = e
+		copy(entries[i+1:], n.entries[i:])
+		return &node[K, V]{bitmap: n.bitmap | bit, entries: entries}, true
+	}
+
+	existing := n.entries[i]
+	if existing.child != nil {
+		child, added := existing.child.put(shift+bitsPerLevel, e)
+		return n.withEntry(i, entry[K, V]{child: child}), added
+	}
+	if existing.hash == e.hash && existing.key == e.key {
+		return n.withEntry(i, e), false
+	}
+
+	return n.withEntry(i, entry[K, V]{child: merge(shift+bitsPerLevel, existing, e)}), true
+}
+
+/*
+Creates the smallest sub-tree holding the two given entries with different keys
+*/
+func merge[K comparable, V any](shift uint, a entry[K, V], b entry[K, V]) *node[K, V] {
+	if shift >= collisionShift {
+		return &node[K, V]{entries: []entry[K, V]{a, b}}
+	}
+
+	bitA, bitB := bitFor(a.hash, shift), bitFor(b.hash, shift)
+	if bitA == bitB {
+		return &node[K, V]{
+			bitmap:  bitA,
+			entries: []entry[K, V]{{child: merge(shift+bitsPerLevel, a, b)}},
+		}
+	}
+	if bitA > bitB {
+		a, b = b, a
+	}
+
+	return &node[K, V]{bitmap: bitA | bitB, entries: []entry[K, V]{a, b}}
+}
+
+/*
+Returns a copy of the node without the given key. Returns the node itself and false if the key is absent
+*/
+func (n *node[K, V]) remove(shift uint, hash uint64, key K) (*node[K, V], bool) {
+	if shift >= collisionShift {
+		for i := range n.entries {
+			if n.entries[i].key == key {
+				return n.withoutEntry(0, i), true
+			}
+		}
+		return n, false
+	}
+
+	bit := bitFor(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	i := n.index(bit)
+	existing := n.entries[i]
+	if existing.child == nil {
+		if existing.hash != hash || existing.key != key {
+			return n, false
+		}
+		return n.withoutEntry(bit, i), true
+	}
+
+	child, removed := existing.child.remove(shift+bitsPerLevel, hash, key)
+	if !removed {
+		return n, false
+	}
+
+	// a sub-node left with a single key is replaced by that key
+	if len(child.entries) == 1 && child.entries[0].child == nil {
+		return n.withEntry(i, child.entries[0]), true
+	}
+
+	return n.withEntry(i, entry[K, V]{child: child}), true
+}
+
+func (n *node[K, V]) withEntry(i int, e entry[K, V]) *node[K, V] {
+	entries := make([]entry[K, V], len(n.entries))
+	copy(entries, n.entries)
+	entries[i] = e
+
+	return &node[K, V]{bitmap: n.bitmap, entries: entries}
+}
+
+func (n *node[K, V]) withoutEntry(bit uint32, i int) *node[K, V] {
+	entries := make([]entry[K, V], 0, len(n.entries)-1)
+	entries = append(entries, n.entries[:i]...)
+	entries = append(entries, n.entries[i+1:]...)
+
+	return &node[K, V]{bitmap: n.bitmap &^ bit, entries: entries}
+}
+
+func (n *node[K, V]) forEach(do func(*entry[K, V]) bool) bool {
+	for i := range n.entries {
+		e := &n.entries[i]
+		if e.child != nil {
+			if !e.child.forEach(do) {
+				return false
+			}
+		} else if !do(e) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Walks the two tries side by side and reports every key which is only in "a", only in "b", or in both with values
+which are not equal. Sub-trees which are shared by both tries are skipped, so comparing two versions of a Map
+takes time proportional to the number of differences. "report" receives nil for the side a key is missing from
+and stops the walk by returning false
+*/
+func diff[K comparable, V any](
+	shift uint,
+	a *node[K, V],
+	b *node[K, V],
+	equals func(*V, *V) bool,
+	report func(*entry[K, V], *entry[K, V]) bool,
+) bool {
+	if a == b {
+		return true
+	}
+
+	if shift >= collisionShift {
+		return diffByKey(a, b, equals, report)
+	}
+
+	for union := a.bitmap | b.bitmap; union != 0; union &= union - 1 {
+		bit := union & -union
+		switch {
+		case a.bitmap&bit == 0:
+			if !reportAll(&b.entries[b.index(bit)], func(e *entry[K, V]) bool { return report(nil, e) }) {
+				return false
+			}
+		case b.bitmap&bit == 0:
+			if !reportAll(&a.entries[a.index(bit)], func(e *entry[K, V]) bool { return report(e, nil) }) {
+				return false
+			}
+		default:
+			if !diffEntries(shift+bitsPerLevel, &a.entries[a.index(bit)], &b.entries[b.index(bit)], equals, report) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func diffEntries[K comparable, V any](
+	shift uint,
+	a *entry[K, V],
+	b *entry[K, V],
+	equals func(*V, *V) bool,
+	report func(*entry[K, V], *entry[K, V]) bool,
+) bool {
+	switch {
+	case a.child != nil && b.child != nil:
+		return diff(shift, a.child, b.child, equals, report)
+	case a.child != nil || b.child != nil:
+		return diffByKey(asNode(a), asNode(b), equals, report)
+	case a.key == b.key:
+		return equals(&a.value, &b.value) || report(a, b)
+	default:
+		return report(a, nil) && report(nil, b)
+	}
+}
+
+func asNode[K comparable, V any](e *entry[K, V]) *node[K, V] {
+	if e.child != nil {
+		return e.child
+	}
+
+	return &node[K, V]{entries: []entry[K, V]{*e}}
+}
+
+/*
+Compares two small sub-trees by looking up each key of one in the other
+*/
+func diffByKey[K comparable, V any](
+	a *node[K, V],
+	b *node[K, V],
+	equals func(*V, *V) bool,
+	report func(*entry[K, V], *entry[K, V]) bool,
+) bool {
+	keys := make(map[K]*entry[K, V])
+	b.forEach(func(e *entry[K, V]) bool {
+		keys[e.key] = e
+		return true
+	})
+
+	if !a.forEach(func(e *entry[K, V]) bool {
+		other, found := keys[e.key]
+		if !found {
+			return report(e, nil)
+		}
+		delete(keys, e.key)
+		return equals(&e.value, &other.value) || report(e, other)
+	}) {
+		return false
+	}
+
+	return b.forEach(func(e *entry[K, V]) bool {
+		if _, remaining := keys[e.key]; remaining {
+			return report(nil, e)
+		}
+		return true
+	})
+}
+
+func reportAll[K comparable, V any](e *entry[K, V], report func(*entry[K, V]) bool) bool {
+	if e.child != nil {
+		return e.child.forEach(report)
+	}
+
+	return report(e)
+}
@@ -0,0 +1,227 @@
+package persistentmap
+
+import (
+	"github.com/golanglibs/gocollections/comparer"
+)
+
+/*
+Persistent map implemented as a hash array mapped trie (HAMT). A Map is immutable: Put and Remove return new
+versions in O(log32 n) time, which share every node but the ones on the path to the changed key with the Map
+they were created from. Keeping many versions around, for example snapshots of application state, is therefore
+cheap, and two versions can be compared in time proportional to their differences through Equals and Diff.
+Since a Map never changes, it can be shared and read by any number of goroutines without synchronization.
+The zero value is an empty Map using comparer.DefaultHash.
+Implements ReadOnlyMapper
+*/
+type Map[K comparable, V any] struct {
+	hash func(*K) uint64
+	root *node[K, V]
+	size int
+}
+
+/*
+Describes a key whose entry differs between two versions of a Map. "Old" is nil if the key was added and "New"
+is nil if the key was removed
+*/
+type Change[K comparable, V any] struct {
+	Key K
+	Old *V
+	New *V
+}
+
+/*
+Creates a new empty Map which hashes keys with comparer.DefaultHash and returns it
+*/
+func New[K comparable, V any]() Map[K, V] {
+	return NewWithHash[K, V](comparer.DefaultHash[K])
+}
+
+/*
+Creates a new empty Map which hashes keys with the given function and returns it. Keys which are equal must
+have equal hashes
+*/
+func NewWithHash[K comparable, V any](hash func(*K) uint64) Map[K, V] {
+	return Map[K, V]{hash: hash, root: &node[K, V]{}}
+}
+
+/*
+Creates a new Map with the keys and values of the given builtin map which hashes keys with comparer.DefaultHash
+and returns it
+*/
+func NewFromMap[K comparable, V any](m map[K]V) Map[K, V] {
+	result := New[K, V]()
+	for key, value := range m {
+		result = result.Put(key, value)
+	}
+
+	return result
+}
+
+/*
+Returns the number of keys in the Map.
+Implements ReadOnlyMapper.Size
+*/
+func (m Map[K, V]) Size() int {
+	return m.size
+}
+
+/*
+Returns true if the Map is empty.
+Implements ReadOnlyMapper.Empty
+*/
+func (m Map[K, V]) Empty() bool {
+	return m.size == 0
+}
+
+/*
+Returns a copy of the value associated with the given key and true, or the zero value and false if the key is
+absent.
+Implements ReadOnlyMapper.Get
+*/
+func (m Map[K, V]) Get(key K) (V, bool) {
+	if m.root == nil {
+		var zero V
+		return zero, false
+	}
+
+	e := m.root.find(m.hashOf(&key), key)
+	if e == nil {
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+/*
+Returns true if the given key exists in the Map.
+Implements ReadOnlyMapper.ContainsKey
+*/
+func (m Map[K, V]) ContainsKey(key K) bool {
+	_, found := m.Get(key)
+	return found
+}
+
+/*
+Returns a new version of the Map which associates the given key with the given value
+*/
+func (m Map[K, V]) Put(key K, value V) Map[K, V] {
+	m.ensureInitialized()
+
+	root, added := m.root.put(0, entry[K, V]{hash: m.hashOf(&key), key: key, value: value})
+	m.root = root
+	if added {
+		m.size++
+	}
+
+	return m
+}
+
+/*
+Returns a new version of the Map without the given key. If the key is absent, the Map itself is returned
+*/
+func (m Map[K, V]) Remove(key K) Map[K, V] {
+	if m.root == nil {
+		return m
+	}
+
+	root, removed := m.root.remove(0, m.hashOf(&key), key)
+	if removed {
+		m.root = root
+		m.size--
+	}
+
+	return m
+}
+
+/*
+Iterates through each key of the Map and executes the given function on references to copies of the key and
+its value. The iteration order depends on the hashes of the keys and is the same for every Map with the same
+keys.
+Implements ReadOnlyMapper.ForEach
+*/
+func (m Map[K, V]) ForEach(do func(*K, *V)) {
+	if m.root == nil {
+		return
+	}
+
+	m.root.forEach(func(e *entry[K, V]) bool {
+		key, value := e.key, e.value
+		do(&key, &value)
+		return true
+	})
+}
+
+/*
+Returns a builtin map with the keys and values of the Map
+*/
+func (m Map[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, m.size)
+	m.ForEach(func(key *K, value *V) {
+		result[*key] = *value
+	})
+
+	return result
+}
+
+/*
+Returns true if the given Map has the same keys as the Map, associated with values which are equal according
+to the given function. Sub-trees shared by the two Maps are not compared, so comparing two versions derived
+from each other takes time proportional to their differences. Both Maps must hash keys with the same function,
+which is always the case for versions derived from each other
+*/
+func (m Map[K, V]) Equals(other Map[K, V], equals func(*V, *V) bool) bool {
+	if m.size != other.size {
+		return false
+	}
+
+	return diff(0, m.rootNode(), other.rootNode(), equals, func(*entry[K, V], *entry[K, V]) bool {
+		return false
+	})
+}
+
+/*
+Executes the given function for every key which was added, removed or associated with a value which is not
+equal according to the given function in "newer" compared to the Map. Sub-trees shared by the two Maps are
+skipped, so diffing two versions derived from each other takes time proportional to their differences. Both
+Maps must hash keys with the same function, which is always the case for versions derived from each other
+*/
+func (m Map[K, V]) Diff(newer Map[K, V], equals func(*V, *V) bool, do func(*Change[K, V])) {
+	diff(0, m.rootNode(), newer.rootNode(), equals, func(old *entry[K, V], new *entry[K, V]) bool {
+		change := Change[K, V]{}
+		if old != nil {
+			value := old.value
+			change.Key, change.Old = old.key, &value
+		}
+		if new != nil {
+			value := new.value
+			change.Key, change.New = new.key, &value
+		}
+
+		do(&change)
+		return true
+	})
+}
+
+func (m Map[K, V]) hashOf(key *K) uint64 {
+	if m.hash == nil {
+		return comparer.DefaultHash(key)
+	}
+
+	return m.hash(key)
+}
+
+func (m Map[K, V]) rootNode() *node[K, V] {
+	if m.root == nil {
+		return &node[K, V]{}
+	}
+
+	return m.root
+}
+
+// the zero value of Map is an empty map without a root
+func (m *Map[K, V]) ensureInitialized() {
+	if m.root == nil {
+		m.root = &node[K, V]{}
+	}
+}
@@ -0,0 +1,234 @@
+package persistentmap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/maps"
+)
+
+func testReadOnlyMapper[K any, V any](m maps.ReadOnlyMapper[K, V]) {}
+
+// hashes every key into one of four buckets, so that most keys collide
+func collidingHash(key *int) uint64 {
+	return uint64(*key % 4)
+}
+
+func equalInts(a *int, b *int) bool {
+	return *a == *b
+}
+
+func sortedKeys[V any](m Map[int, V]) []int {
+	keys := make([]int, 0, m.Size())
+	m.ForEach(func(key *int, _ *V) {
+		keys = append(keys, *key)
+	})
+	sort.Ints(keys)
+
+	return keys
+}
+
+func collectChanges(old Map[int, int], newer Map[int, int]) map[int][2]*int {
+	changes := make(map[int][2]*int)
+	old.Diff(newer, equalInts, func(change *Change[int, int]) {
+		changes[change.Key] = [2]*int{change.Old, change.New}
+	})
+
+	return changes
+}
+
+func Test_MapShouldImplementReadOnlyMapper(t *testing.T) {
+	testReadOnlyMapper[int, string](New[int, string]())
+}
+
+func Test_MapPutAndGet(t *testing.T) {
+	m := New[string, int]().Put("a", 1).Put("b", 2).Put("a", 3)
+
+	a, aFound := m.Get("a")
+	b, bFound := m.Get("b")
+	_, cFound := m.Get("c")
+
+	goassert.Equal(t, 2, m.Size())
+	goassert.False(t, m.Empty())
+	goassert.Equal(t, 3, a)
+	goassert.True(t, aFound)
+	goassert.Equal(t, 2, b)
+	goassert.True(t, bFound)
+	goassert.False(t, cFound)
+	goassert.True(t, m.ContainsKey("b"))
+	goassert.False(t, m.ContainsKey("c"))
+}
+
+func Test_ZeroValueMapShouldBeEmpty(t *testing.T) {
+	var m Map[int, int]
+
+	_, found := m.Get(1)
+
+	goassert.True(t, m.Empty())
+	goassert.False(t, found)
+	goassert.Equal(t, 0, m.Remove(1).Size())
+	goassert.Equal(t, 1, m.Put(1, 1).Size())
+	goassert.True(t, m.Equals(New[int, int](), equalInts))
+}
+
+func Test_MapPutAndRemoveShouldReturnNewVersions_AndLeaveOldVersionsUntouched(t *testing.T) {
+	versions := []Map[int, int]{New[int, int]()}
+	for i := 0; i < 1000; i++ {
+		versions = append(versions, versions[i].Put(i, i*i))
+	}
+	for i := 0; i < 1000; i++ {
+		versions = append(versions, versions[1000+i].Remove(i))
+	}
+
+	for i := 0; i <= 1000; i++ {
+		goassert.Equal(t, i, versions[i].Size())
+		goassert.Equal(t, 1000-i, versions[1000+i].Size())
+	}
+	for i := 0; i < 1000; i++ {
+		value, found := versions[1000].Get(i)
+		goassert.Equal(t, i*i, value)
+		goassert.True(t, found)
+		goassert.False(t, versions[500].ContainsKey(500+i))
+		goassert.Equal(t, i >= 500, versions[1500].ContainsKey(i))
+	}
+}
+
+func Test_MapRemoveShouldReturnSameVersion_GivenKeyIsAbsent(t *testing.T) {
+	m := New[int, int]().Put(1, 1)
+
+	removed := m.Remove(2)
+
+	goassert.Equal(t, 1, removed.Size())
+	goassert.True(t, m.root == removed.root)
+}
+
+func Test_MapShouldHandleCollidingHashes(t *testing.T) {
+	m := NewWithHash[int, int](collidingHash)
+	for i := 0; i < 100; i++ {
+		m = m.Put(i, -i)
+	}
+	for i := 0; i < 100; i += 3 {
+		m = m.Remove(i)
+	}
+
+	for i := 0; i < 100; i++ {
+		value, found := m.Get(i)
+		goassert.Equal(t, i%3 != 0, found)
+		if found {
+			goassert.Equal(t, -i, value)
+		}
+	}
+	goassert.Equal(t, 66, m.Size())
+}
+
+func Test_MapShouldHaveSameShape_RegardlessOfHistory(t *testing.T) {
+	m := NewWithHash[int, int](collidingHash).Put(0, 0).Put(4, 4)
+
+	removed := m.Put(8, 8).Remove(8).Remove(4)
+
+	goassert.Equal(t, 1, len(removed.root.entries))
+	goassert.True(t, removed.root.entries[0].child == nil)
+	goassert.True(t, removed.Equals(NewWithHash[int, int](collidingHash).Put(0, 0), equalInts))
+}
+
+func Test_MapForEachShouldIterateCopies(t *testing.T) {
+	m := New[int, int]().Put(1, 10).Put(2, 20)
+
+	m.ForEach(func(key *int, value *int) {
+		*key = 0
+		*value = 0
+	})
+
+	goassert.DeepEqual(t, []int{1, 2}, sortedKeys(m))
+	goassert.DeepEqual(t, map[int]int{1: 10, 2: 20}, m.ToMap())
+}
+
+func Test_NewFromMap(t *testing.T) {
+	m := NewFromMap(map[string]int{"a": 1, "b": 2})
+
+	goassert.Equal(t, 2, m.Size())
+	goassert.DeepEqual(t, map[string]int{"a": 1, "b": 2}, m.ToMap())
+}
+
+func Test_MapEquals(t *testing.T) {
+	a := New[int, int]()
+	b := New[int, int]()
+	for i := 0; i < 500; i++ {
+		a = a.Put(i, i)
+		b = b.Put(499-i, 499-i)
+	}
+
+	goassert.True(t, a.Equals(b, equalInts))
+	goassert.True(t, a.Equals(a.Put(10, 10), equalInts))
+	goassert.False(t, a.Equals(a.Put(10, 11), equalInts))
+	goassert.False(t, a.Equals(a.Remove(10), equalInts))
+	goassert.False(t, a.Equals(a.Remove(10).Put(500, 500), equalInts))
+	goassert.True(t, a.Equals(b.Put(10, 11), func(*int, *int) bool { return true }))
+}
+
+func Test_MapDiff(t *testing.T) {
+	old := New[int, int]()
+	for i := 0; i < 1000; i++ {
+		old = old.Put(i, i)
+	}
+
+	newer := old.Remove(3).Put(7, -7).Put(1000, 1000).Put(8, 8)
+	changes := collectChanges(old, newer)
+
+	goassert.Equal(t, 3, len(changes))
+	goassert.Equal(t, 3, *changes[3][0])
+	goassert.Nil(t, changes[3][1])
+	goassert.Equal(t, 7, *changes[7][0])
+	goassert.Equal(t, -7, *changes[7][1])
+	goassert.Nil(t, changes[1000][0])
+	goassert.Equal(t, 1000, *changes[1000][1])
+	goassert.Equal(t, 0, len(collectChanges(old, old)))
+}
+
+func Test_MapDiffAndEqualsShouldMatchBuiltinMaps_GivenRandomVersions(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+
+	for _, hash := range []func(*int) uint64{comparer.DefaultHash[int], collidingHash} {
+		versions := []Map[int, int]{NewWithHash[int, int](hash)}
+		for i := 0; i < 300; i++ {
+			version := versions[random.Intn(len(versions))]
+			for j := random.Intn(20); j >= 0; j-- {
+				key := random.Intn(200)
+				if random.Intn(3) == 0 {
+					version = version.Remove(key)
+				} else {
+					version = version.Put(key, random.Intn(3))
+				}
+			}
+			versions = append(versions, version)
+		}
+
+		for i := 0; i < 300; i++ {
+			a, b := versions[random.Intn(len(versions))], versions[random.Intn(len(versions))]
+			expectedA, expectedB := a.ToMap(), b.ToMap()
+
+			expected := make(map[int][2]*int)
+			for key, value := range expectedA {
+				value := value
+				if other, found := expectedB[key]; !found {
+					expected[key] = [2]*int{&value, nil}
+				} else if other != value {
+					other := other
+					expected[key] = [2]*int{&value, &other}
+				}
+			}
+			for key, value := range expectedB {
+				value := value
+				if _, found := expectedA[key]; !found {
+					expected[key] = [2]*int{nil, &value}
+				}
+			}
+
+			goassert.DeepEqual(t, expected, collectChanges(a, b))
+			goassert.Equal(t, len(expected) == 0, a.Equals(b, equalInts))
+		}
+	}
+}
@@ -0,0 +1,492 @@
+package skiplist
+
+import (
+	"sync/atomic"
+
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Successor of a node on one level together with the deletion mark of the node, like Java's AtomicMarkableReference.
+Markers are never modified, so a link is changed by swapping the whole marker with compare-and-swap
+*/
+type marker[K any, V any] struct {
+	to     *concurrentNode[K, V]
+	marked bool
+}
+
+/*
+Node of a ConcurrentMap. A nil value means the key was removed, which is the moment the removal takes effect.
+The links of the node are marked afterwards, and marked nodes are unlinked by whichever goroutine runs into them
+*/
+type concurrentNode[K any, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	next  []atomic.Pointer[marker[K, V]]
+}
+
+func newConcurrentNode[K any, V any](key K, level int) *concurrentNode[K, V] {
+	n := &concurrentNode[K, V]{
+		key:  key,
+		next: make([]atomic.Pointer[marker[K, V]], level),
+	}
+	for i := range n.next {
+		n.next[i].Store(&marker[K, V]{})
+	}
+
+	return n
+}
+
+/*
+Marks every link of the given node, top down, so that no node can be inserted after it anymore
+*/
+func (n *concurrentNode[K, V]) markLinks() {
+	for i := len(n.next) - 1; i >= 0; i-- {
+		for {
+			current := n.next[i].Load()
+			if current.marked || n.next[i].CompareAndSwap(current, &marker[K, V]{to: current.to, marked: true}) {
+				break
+			}
+		}
+	}
+}
+
+/*
+Lock-free sorted map implemented as a skip list in the spirit of Java's ConcurrentSkipListMap, based on the
+lock-free skip list of Herlihy and Shavit. Put, Get and Remove take O(log n) expected time and never block, so
+any number of goroutines can use a ConcurrentMap at the same time without locks. Reads only write to the
+ConcurrentMap to help unlinking removed nodes.
+Iteration through ForEach and Range is weakly consistent: it never fails because of concurrent modifications and
+visits every key which exists during the whole iteration, but keys added or removed while iterating may or may
+not be visited. Size is a snapshot which may be stale by the time it is returned. Rank queries are not supported
+because keeping the spans of the links consistent would require locking.
+Implements Mapper and ReadOnlyMapper.
+A ConcurrentMap must not be copied after first use, which is why its constructors return a pointer
+*/
+type ConcurrentMap[K any, V any] struct {
+	compare comparer.Comparator[K]
+	head    *concurrentNode[K, V]
+	size    atomic.Int64
+	random  atomic.Uint64
+}
+
+/*
+Creates a new instance of empty ConcurrentMap which orders the keys in their natural ascending order and returns
+pointer to the instance
+*/
+func NewConcurrent[K comparer.Ordered, V any]() *ConcurrentMap[K, V] {
+	return NewConcurrentWithComparator[K, V](comparer.Natural[K]())
+}
+
+/*
+Creates a new instance of empty ConcurrentMap which orders the keys with the given Comparator and returns
+pointer to the instance. Keys the Comparator orders equally are considered the same key
+*/
+func NewConcurrentWithComparator[K any, V any](compare comparer.Comparator[K]) *ConcurrentMap[K, V] {
+	var key K
+	m := &ConcurrentMap[K, V]{
+		compare: compare,
+		head:    newConcurrentNode[K, V](key, maxLevel),
+	}
+	m.random.Store(seed)
+
+	return m
+}
+
+/*
+Returns the number of keys in the ConcurrentMap at the time of the call. When other goroutines modify the
+ConcurrentMap concurrently, the returned value is only a snapshot.
+Implements ReadOnlyMapper.Size
+*/
+func (m *ConcurrentMap[K, V]) Size() int {
+	// a key may be counted as removed before it was counted as added
+	if size := m.size.Load(); size > 0 {
+		return int(size)
+	}
+
+	return 0
+}
+
+/*
+Returns true if the ConcurrentMap is empty at the time of the call. Otherwise, false.
+Implements ReadOnlyMapper.Empty
+*/
+func (m *ConcurrentMap[K, V]) Empty() bool {
+	return m.first() == nil
+}
+
+/*
+Returns the value associated with the given key and true, or the zero value and false if the key does not
+exist.
+Implements ReadOnlyMapper.Get
+*/
+func (m *ConcurrentMap[K, V]) Get(key K) (V, bool) {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	if m.find(&key, &preds, &succs) {
+		if value := succs[0].value.Load(); value != nil {
+			return *value, true
+		}
+	}
+
+	var value V
+	return value, false
+}
+
+/*
+Returns true if the given key exists in the ConcurrentMap. Otherwise, false.
+Implements ReadOnlyMapper.ContainsKey
+*/
+func (m *ConcurrentMap[K, V]) ContainsKey(key K) bool {
+	_, found := m.Get(key)
+	return found
+}
+
+/*
+Associates the given value with the given key. Returns true if the key was added and false if the value of an
+existing key was replaced.
+Implements Mapper.Put
+*/
+func (m *ConcurrentMap[K, V]) Put(key K, value V) bool {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	level := m.randomLevel()
+
+	for {
+		if m.find(&key, &preds, &succs) {
+			existing := succs[0]
+			if current := existing.value.Load(); current != nil {
+				if existing.value.CompareAndSwap(current, &value) {
+					return false
+				}
+				continue
+			}
+
+			// the key is being removed: help the remover and insert a new node once it is unlinked
+			existing.markLinks()
+			continue
+		}
+
+		n := newConcurrentNode[K, V](key, level)
+		n.value.Store(&value)
+		for i := 0; i < level; i++ {
+			n.next[i].Store(&marker[K, V]{to: succs[i]})
+		}
+
+		// linking the bottom level is the moment the key is added
+		if !linkAfter(preds[0], succs[0], n, 0) {
+			continue
+		}
+		m.size.Add(1)
+
+		m.linkUpperLevels(n, &preds, &succs)
+
+		return true
+	}
+}
+
+func (m *ConcurrentMap[K, V]) linkUpperLevels(
+	n *concurrentNode[K, V],
+	preds *[maxLevel]*concurrentNode[K, V],
+	succs *[maxLevel]*concurrentNode[K, V],
+) {
+	for i := 1; i < len(n.next); i++ {
+		for {
+			current := n.next[i].Load()
+			if current.marked {
+				// the node is already being removed, so linking it higher is pointless
+				return
+			}
+			if current.to != succs[i] && !n.next[i].CompareAndSwap(current, &marker[K, V]{to: succs[i]}) {
+				continue
+			}
+			if linkAfter(preds[i], succs[i], n, i) {
+				break
+			}
+
+			if !m.find(&n.key, preds, succs) || succs[0] != n {
+				return
+			}
+		}
+	}
+}
+
+/*
+Removes the given key and its value. Returns true if the key was found and removed. Otherwise, false.
+Implements Mapper.Remove
+*/
+func (m *ConcurrentMap[K, V]) Remove(key K) bool {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	if !m.find(&key, &preds, &succs) {
+		return false
+	}
+
+	n := succs[0]
+	for {
+		current := n.value.Load()
+		if current == nil {
+			return false
+		}
+		// clearing the value is the moment the key is removed
+		if n.value.CompareAndSwap(current, nil) {
+			break
+		}
+	}
+	m.size.Add(-1)
+
+	n.markLinks()
+	m.find(&key, &preds, &succs)
+
+	return true
+}
+
+/*
+Removes all keys which exist at the time of the call. Keys are removed one by one, so other goroutines may
+observe a partially cleared ConcurrentMap, and keys added concurrently may survive.
+Implements Mapper.Clear
+*/
+func (m *ConcurrentMap[K, V]) Clear() {
+	for n := m.first(); n != nil; n = m.first() {
+		m.Remove(n.key)
+	}
+}
+
+/*
+Returns the smallest key and its value. Panics if the ConcurrentMap is empty
+*/
+func (m *ConcurrentMap[K, V]) Min() (K, V) {
+	key, value, err := m.TryMin()
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+/*
+Returns the smallest key and its value. Returns an error wrapping errors.ErrEmpty if the ConcurrentMap is empty
+*/
+func (m *ConcurrentMap[K, V]) TryMin() (K, V, error) {
+	for n := m.head.next[0].Load().to; n != nil; n = n.next[0].Load().to {
+		if value := n.value.Load(); value != nil {
+			return n.key, *value, nil
+		}
+	}
+
+	var key K
+	var value V
+	return key, value, errors.Newf(errors.ErrEmpty, "Cannot get Min. ConcurrentMap is empty")
+}
+
+/*
+Returns the largest key and its value. Panics if the ConcurrentMap is empty
+*/
+func (m *ConcurrentMap[K, V]) Max() (K, V) {
+	key, value, err := m.TryMax()
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+/*
+Returns the largest key and its value. Returns an error wrapping errors.ErrEmpty if the ConcurrentMap is empty
+*/
+func (m *ConcurrentMap[K, V]) TryMax() (K, V, error) {
+	if n, value := m.lastLive(nil, true); n != nil {
+		return n.key, *value, nil
+	}
+
+	var key K
+	var value V
+	return key, value, errors.Newf(errors.ErrEmpty, "Cannot get Max. ConcurrentMap is empty")
+}
+
+/*
+Returns the smallest key which is greater than or equal to the given key along with its value and true, or zero
+values and false if there is no such key
+*/
+func (m *ConcurrentMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	m.find(&key, &preds, &succs)
+
+	for n := succs[0]; n != nil; n = n.next[0].Load().to {
+		if value := n.value.Load(); value != nil {
+			return n.key, *value, true
+		}
+	}
+
+	var ceiling K
+	var value V
+	return ceiling, value, false
+}
+
+/*
+Returns the largest key which is less than or equal to the given key along with its value and true, or zero
+values and false if there is no such key
+*/
+func (m *ConcurrentMap[K, V]) Floor(key K) (K, V, bool) {
+	if n, value := m.lastLive(&key, true); n != nil {
+		return n.key, *value, true
+	}
+
+	var floor K
+	var value V
+	return floor, value, false
+}
+
+/*
+Iterates through the keys from "from" (inclusive) to "to" (exclusive) in ascending order and executes the given
+function on a reference to a copy of each key and its value. The iteration is weakly consistent, and the
+ConcurrentMap may be modified from the given function
+*/
+func (m *ConcurrentMap[K, V]) Range(from K, to K, do func(*K, *V)) {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	m.find(&from, &preds, &succs)
+
+	m.forEachFrom(succs[0], &to, do)
+}
+
+/*
+Iterates through the keys in ascending order and executes the given function on a reference to a copy of each
+key and its value. The iteration is weakly consistent, and the ConcurrentMap may be modified from the given
+function.
+Implements ReadOnlyMapper.ForEach
+*/
+func (m *ConcurrentMap[K, V]) ForEach(do func(*K, *V)) {
+	m.forEachFrom(m.head.next[0].Load().to, nil, do)
+}
+
+func (m *ConcurrentMap[K, V]) forEachFrom(start *concurrentNode[K, V], to *K, do func(*K, *V)) {
+	for n := start; n != nil; n = n.next[0].Load().to {
+		if to != nil && m.compare(&n.key, to) >= 0 {
+			return
+		}
+		if value := n.value.Load(); value != nil {
+			key, copied := n.key, *value
+			do(&key, &copied)
+		}
+	}
+}
+
+/*
+Returns the last node which was not removed and whose key is less than the given key (or equal to it if
+"inclusive" is true) along with its value. A nil key stands for a key greater than every other key. Returns nil
+if there is no such node
+*/
+func (m *ConcurrentMap[K, V]) lastLive(key *K, inclusive bool) (*concurrentNode[K, V], *V) {
+	for {
+		x := m.head
+		for i := maxLevel - 1; i >= 0; i-- {
+			for next := x.next[i].Load().to; next != nil && m.before(&next.key, key, inclusive); {
+				x = next
+				next = x.next[i].Load().to
+			}
+		}
+
+		if x == m.head {
+			return nil, nil
+		}
+		if value := x.value.Load(); value != nil {
+			return x, value
+		}
+
+		// the node was removed, so look for the last live node before it
+		key, inclusive = &x.key, false
+	}
+}
+
+func (m *ConcurrentMap[K, V]) before(key *K, bound *K, inclusive bool) bool {
+	if bound == nil {
+		return true
+	}
+
+	order := m.compare(key, bound)
+
+	return order < 0 || (inclusive && order == 0)
+}
+
+func (m *ConcurrentMap[K, V]) first() *concurrentNode[K, V] {
+	for n := m.head.next[0].Load().to; n != nil; n = n.next[0].Load().to {
+		if n.value.Load() != nil {
+			return n
+		}
+	}
+
+	return nil
+}
+
+/*
+Finds the last node of every level whose key is less than the given key and its successor on that level,
+unlinking the marked nodes it runs into. Returns true if the successor on the bottom level has the given key
+*/
+func (m *ConcurrentMap[K, V]) find(
+	key *K,
+	preds *[maxLevel]*concurrentNode[K, V],
+	succs *[maxLevel]*concurrentNode[K, V],
+) bool {
+retry:
+	for {
+		pred := m.head
+		for i := maxLevel - 1; i >= 0; i-- {
+			current := pred.next[i].Load().to
+			for current != nil {
+				next := current.next[i].Load()
+				for next.marked {
+					// unlink the removed node, starting over if the predecessor changed in the meantime
+					expected := pred.next[i].Load()
+					if expected.to != current || expected.marked {
+						continue retry
+					}
+					if !pred.next[i].CompareAndSwap(expected, &marker[K, V]{to: next.to}) {
+						continue retry
+					}
+					current = next.to
+					if current == nil {
+						break
+					}
+					next = current.next[i].Load()
+				}
+				if current == nil || m.compare(&current.key, key) >= 0 {
+					break
+				}
+				pred = current
+				current = next.to
+			}
+			preds[i] = pred
+			succs[i] = current
+		}
+
+		return succs[0] != nil && m.compare(&succs[0].key, key) == 0
+	}
+}
+
+/*
+Links the given node after "pred" on the given level if "pred" is still followed by "succ" and not removed
+*/
+func linkAfter[K any, V any](
+	pred *concurrentNode[K, V],
+	succ *concurrentNode[K, V],
+	n *concurrentNode[K, V],
+	level int,
+) bool {
+	expected := pred.next[level].Load()
+	if expected.to != succ || expected.marked {
+		return false
+	}
+
+	return pred.next[level].CompareAndSwap(expected, &marker[K, V]{to: n})
+}
+
+/*
+Returns a random level like Map.randomLevel. The bits come from a SplitMix64 sequence advanced atomically, so
+goroutines never wait on each other
+*/
+func (m *ConcurrentMap[K, V]) randomLevel() int {
+	z := m.random.Add(seed)
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+
+	return levelFromBits(z)
+}
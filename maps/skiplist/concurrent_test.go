@@ -0,0 +1,245 @@
+package skiplist
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_ConcurrentMapShouldImplementMapper(t *testing.T) {
+	testMapper[int, string](NewConcurrent[int, string]())
+}
+
+func Test_ConcurrentMapPutGetAndRemove(t *testing.T) {
+	m := NewConcurrent[string, int]()
+
+	goassert.True(t, m.Empty())
+	goassert.True(t, m.Put("b", 2))
+	goassert.True(t, m.Put("a", 1))
+	goassert.False(t, m.Put("b", 3))
+
+	b, bFound := m.Get("b")
+	_, cFound := m.Get("c")
+
+	goassert.Equal(t, 2, m.Size())
+	goassert.Equal(t, 3, b)
+	goassert.True(t, bFound)
+	goassert.False(t, cFound)
+	goassert.True(t, m.Remove("a"))
+	goassert.False(t, m.Remove("a"))
+	goassert.False(t, m.ContainsKey("a"))
+	goassert.Equal(t, 1, m.Size())
+}
+
+func Test_ConcurrentMapOrderedQueries(t *testing.T) {
+	m := NewConcurrent[int, string]()
+	for key := 0; key < 20; key += 2 {
+		m.Put(key, "")
+	}
+	m.Remove(18)
+	m.Remove(10)
+
+	minKey, _ := m.Min()
+	maxKey, _ := m.Max()
+	ceiling, _, _ := m.Ceiling(9)
+	floor, _, _ := m.Floor(11)
+	_, _, noFloor := m.Floor(-1)
+	ranged := make([]int, 0)
+	m.Range(3, 14, func(key *int, _ *string) {
+		ranged = append(ranged, *key)
+	})
+
+	goassert.Equal(t, 0, minKey)
+	goassert.Equal(t, 16, maxKey)
+	goassert.Equal(t, 12, ceiling)
+	goassert.Equal(t, 8, floor)
+	goassert.False(t, noFloor)
+	goassert.DeepEqual(t, []int{4, 6, 8, 12}, ranged)
+	goassert.DeepEqual(t, []int{0, 2, 4, 6, 8, 12, 14, 16}, keysOf[string](m))
+}
+
+func Test_ConcurrentMapMinAndMaxShouldPanic_GivenEmptyMap(t *testing.T) {
+	m := NewConcurrent[int, string]()
+	m.Put(1, "")
+	m.Clear()
+
+	_, _, err := m.TryMin()
+
+	goassert.True(t, m.Empty())
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot get Max. ConcurrentMap is empty", func() {
+		m.Max()
+	})
+}
+
+func Test_ConcurrentMapShouldKeepEveryKey_GivenConcurrentPuts(t *testing.T) {
+	m := NewConcurrent[int, int]()
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				m.Put(i*8+worker, worker)
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	keys := keysOf[int](m)
+	goassert.Equal(t, 4000, m.Size())
+	goassert.Equal(t, 4000, len(keys))
+	goassert.True(t, sort.IntsAreSorted(keys))
+}
+
+func Test_ConcurrentMapShouldMatchModel_GivenConcurrentOperationsOnDisjointKeys(t *testing.T) {
+	m := NewConcurrent[int, int]()
+	models := make([]map[int]int, 8)
+
+	var wg sync.WaitGroup
+	for worker := range models {
+		models[worker] = make(map[int]int)
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			random := rand.New(rand.NewSource(int64(worker)))
+			model := models[worker]
+			for i := 0; i < 3000; i++ {
+				// every worker owns the keys which are congruent to its number
+				key := random.Intn(200)*8 + worker
+				if random.Intn(3) == 0 {
+					_, exists := model[key]
+					if m.Remove(key) != exists {
+						t.Errorf("Expected Remove(%d) to return %t", key, exists)
+					}
+					delete(model, key)
+				} else {
+					m.Put(key, i)
+					model[key] = i
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	expected := make([]int, 0)
+	for _, model := range models {
+		for key, value := range model {
+			expected = append(expected, key)
+			actual, found := m.Get(key)
+			goassert.True(t, found)
+			goassert.Equal(t, value, actual)
+		}
+	}
+	sort.Ints(expected)
+	goassert.DeepEqual(t, expected, keysOf[int](m))
+	goassert.Equal(t, len(expected), m.Size())
+}
+
+func Test_ConcurrentMapShouldRemoveEachKeyOnce_GivenConcurrentRemoves(t *testing.T) {
+	m := NewConcurrent[int, int]()
+	for key := 0; key < 1000; key++ {
+		m.Put(key, key)
+	}
+
+	removed := make([]int, 8)
+	var wg sync.WaitGroup
+	for worker := range removed {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for key := 0; key < 1000; key++ {
+				if m.Remove(key) {
+					removed[worker]++
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, count := range removed {
+		total += count
+	}
+	goassert.Equal(t, 1000, total)
+	goassert.True(t, m.Empty())
+	goassert.Equal(t, 0, m.Size())
+}
+
+func Test_ConcurrentMapShouldBeReadable_WhileBeingModified(t *testing.T) {
+	m := NewConcurrent[int, int]()
+	for key := 0; key < 1000; key += 2 {
+		m.Put(key, key)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for key := 1; key < 1000; key += 2 {
+			m.Put(key, key)
+			m.Remove(key)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			previous := -1
+			m.ForEach(func(key *int, value *int) {
+				if *key <= previous || *key != *value {
+					t.Errorf("Expected keys in ascending order with matching values")
+				}
+				previous = *key
+			})
+			for key := 0; key < 1000; key += 2 {
+				if !m.ContainsKey(key) {
+					t.Errorf("Expected key %d to exist during the whole test", key)
+				}
+			}
+		}
+	}()
+	wg.Wait()
+
+	goassert.Equal(t, 500, m.Size())
+}
+
+func Test_ConcurrentMapShouldStayConsistent_GivenConcurrentOperationsOnSameKeys(t *testing.T) {
+	m := NewConcurrent[int, int]()
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			random := rand.New(rand.NewSource(int64(worker)))
+			for i := 0; i < 5000; i++ {
+				key := random.Intn(64)
+				if random.Intn(2) == 0 {
+					m.Remove(key)
+				} else {
+					m.Put(key, key)
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	keys := keysOf[int](m)
+	for i := 1; i < len(keys); i++ {
+		goassert.True(t, keys[i-1] < keys[i])
+	}
+	for key := 0; key < 64; key++ {
+		value, found := m.Get(key)
+		goassert.Equal(t, found, sort.SearchInts(keys, key) < len(keys) && keys[sort.SearchInts(keys, key)] == key)
+		if found {
+			goassert.Equal(t, key, value)
+		}
+	}
+	goassert.Equal(t, len(keys), m.Size())
+}
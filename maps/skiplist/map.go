@@ -0,0 +1,404 @@
+package skiplist
+
+import (
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+)
+
+const (
+	// enough levels for 2^64 keys with a promotion probability of 1/4
+	maxLevel = 32
+	seed     = 0x9e3779b97f4a7c15
+)
+
+type link[K any, V any] struct {
+	to *node[K, V]
+	// number of level 0 steps the link skips, which is used to compute ranks
+	span int
+}
+
+type node[K any, V any] struct {
+	key   K
+	value V
+	next  []link[K, V]
+}
+
+/*
+Sorted map implemented as an indexable skip list. Keys are kept in the order of the given Comparator, so the map
+can be iterated in order, scanned by range and queried by rank. Put, Get, Remove, Rank and At take O(log n)
+expected time. Every link also stores the number of keys it skips, which is what makes the rank queries fast.
+Implements Mapper and ReadOnlyMapper.
+Map is not thread safe, see ConcurrentMap for a lock-free variant
+*/
+type Map[K any, V any] struct {
+	compare comparer.Comparator[K]
+	head    *node[K, V]
+	level   int
+	size    int
+	random  uint64
+}
+
+/*
+Creates a new instance of empty Map which orders the keys in their natural ascending order and returns it
+*/
+func New[K comparer.Ordered, V any]() Map[K, V] {
+	return NewWithComparator[K, V](comparer.Natural[K]())
+}
+
+/*
+Creates a new instance of empty Map which orders the keys with the given Comparator and returns it. Keys the
+Comparator orders equally are considered the same key
+*/
+func NewWithComparator[K any, V any](compare comparer.Comparator[K]) Map[K, V] {
+	return Map[K, V]{
+		compare: compare,
+		head:    &node[K, V]{next: make([]link[K, V], maxLevel)},
+		level:   1,
+		random:  seed,
+	}
+}
+
+/*
+Returns the number of keys in the Map.
+Implements ReadOnlyMapper.Size
+*/
+func (m *Map[K, V]) Size() int {
+	return m.size
+}
+
+/*
+Returns true if the Map is empty. Otherwise, false.
+Implements ReadOnlyMapper.Empty
+*/
+func (m *Map[K, V]) Empty() bool {
+	return m.size == 0
+}
+
+/*
+Returns the value associated with the given key and true, or the zero value and false if the key does not
+exist. Time complexity is O(log n) expected.
+Implements ReadOnlyMapper.Get
+*/
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	if n := m.ceiling(&key); n != nil && m.compare(&n.key, &key) == 0 {
+		return n.value, true
+	}
+
+	var value V
+	return value, false
+}
+
+/*
+Returns true if the given key exists in the Map. Otherwise, false.
+Implements ReadOnlyMapper.ContainsKey
+*/
+func (m *Map[K, V]) ContainsKey(key K) bool {
+	_, found := m.Get(key)
+	return found
+}
+
+/*
+Associates the given value with the given key. Returns true if the key was added and false if the value of an
+existing key was replaced. Time complexity is O(log n) expected.
+Implements Mapper.Put
+*/
+func (m *Map[K, V]) Put(key K, value V) bool {
+	var update [maxLevel]*node[K, V]
+	var rank [maxLevel]int
+	m.search(&key, &update, &rank)
+
+	if n := update[0].next[0].to; n != nil && m.compare(&n.key, &key) == 0 {
+		n.value = value
+		return false
+	}
+
+	level := m.randomLevel()
+	if level > m.level {
+		for i := m.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = m.head
+			m.head.next[i] = link[K, V]{span: m.size}
+		}
+		m.level = level
+	}
+
+	n := &node[K, V]{key: key, value: value, next: make([]link[K, V], level)}
+	for i := 0; i < level; i++ {
+		previous := &update[i].next[i]
+		n.next[i] = link[K, V]{to: previous.to, span: previous.span - (rank[0] - rank[i])}
+		*previous = link[K, V]{to: n, span: rank[0] - rank[i] + 1}
+	}
+	for i := level; i < m.level; i++ {
+		update[i].next[i].span++
+	}
+	m.size++
+
+	return true
+}
+
+/*
+Removes the given key and its value. Returns true if the key was found and removed. Otherwise, false. Time
+complexity is O(log n) expected.
+Implements Mapper.Remove
+*/
+func (m *Map[K, V]) Remove(key K) bool {
+	var update [maxLevel]*node[K, V]
+	var rank [maxLevel]int
+	m.search(&key, &update, &rank)
+
+	n := update[0].next[0].to
+	if n == nil || m.compare(&n.key, &key) != 0 {
+		return false
+	}
+
+	for i := 0; i < m.level; i++ {
+		previous := &update[i].next[i]
+		if previous.to == n {
+			*previous = link[K, V]{to: n.next[i].to, span: previous.span + n.next[i].span - 1}
+		} else {
+			previous.span--
+		}
+	}
+	for m.level > 1 && m.head.next[m.level-1].to == nil {
+		m.level--
+	}
+	m.size--
+
+	return true
+}
+
+/*
+Removes all keys from the Map.
+Implements Mapper.Clear
+*/
+func (m *Map[K, V]) Clear() {
+	m.head = &node[K, V]{next: make([]link[K, V], maxLevel)}
+	m.level = 1
+	m.size = 0
+}
+
+/*
+Returns the smallest key and its value. Panics if the Map is empty
+*/
+func (m *Map[K, V]) Min() (K, V) {
+	key, value, err := m.TryMin()
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+/*
+Returns the smallest key and its value. Returns an error wrapping errors.ErrEmpty if the Map is empty
+*/
+func (m *Map[K, V]) TryMin() (K, V, error) {
+	if m.Empty() {
+		var key K
+		var value V
+		return key, value, errors.Newf(errors.ErrEmpty, "Cannot get Min. Map is empty")
+	}
+
+	first := m.head.next[0].to
+
+	return first.key, first.value, nil
+}
+
+/*
+Returns the largest key and its value. Panics if the Map is empty
+*/
+func (m *Map[K, V]) Max() (K, V) {
+	key, value, err := m.TryMax()
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+/*
+Returns the largest key and its value. Returns an error wrapping errors.ErrEmpty if the Map is empty. Time
+complexity is O(log n) expected
+*/
+func (m *Map[K, V]) TryMax() (K, V, error) {
+	if m.Empty() {
+		var key K
+		var value V
+		return key, value, errors.Newf(errors.ErrEmpty, "Cannot get Max. Map is empty")
+	}
+
+	x := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for x.next[i].to != nil {
+			x = x.next[i].to
+		}
+	}
+
+	return x.key, x.value, nil
+}
+
+/*
+Returns the smallest key which is greater than or equal to the given key along with its value and true, or zero
+values and false if there is no such key
+*/
+func (m *Map[K, V]) Ceiling(key K) (K, V, bool) {
+	return entryOf(m.ceiling(&key))
+}
+
+/*
+Returns the largest key which is less than or equal to the given key along with its value and true, or zero
+values and false if there is no such key
+*/
+func (m *Map[K, V]) Floor(key K) (K, V, bool) {
+	x := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for x.next[i].to != nil && m.compare(&x.next[i].to.key, &key) <= 0 {
+			x = x.next[i].to
+		}
+	}
+
+	if x == m.head {
+		return entryOf[K, V](nil)
+	}
+
+	return entryOf(x)
+}
+
+/*
+Returns the number of keys which are less than the given key. The key does not need to exist in the Map, and if
+it exists, the returned value is its index in the sorted order of the keys. Time complexity is O(log n) expected
+*/
+func (m *Map[K, V]) Rank(key K) int {
+	var update [maxLevel]*node[K, V]
+	var rank [maxLevel]int
+	m.search(&key, &update, &rank)
+
+	return rank[0]
+}
+
+/*
+Returns the key at the given index in the sorted order of the keys along with its value. Panics if the given
+index is out of range
+*/
+func (m *Map[K, V]) At(index int) (K, V) {
+	key, value, err := m.TryAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+/*
+Returns the key at the given index in the sorted order of the keys along with its value. Returns an error
+wrapping errors.ErrIndexOutOfRange if the given index is out of range. Time complexity is O(log n) expected
+*/
+func (m *Map[K, V]) TryAt(index int) (K, V, error) {
+	if index < 0 || index >= m.size {
+		var key K
+		var value V
+		return key, value, errors.Newf(
+			errors.ErrIndexOutOfRange,
+			"Map.At could not retrieve key because given index %d is out of range",
+			index,
+		)
+	}
+
+	x := m.head
+	traversed := 0
+	for i := m.level - 1; i >= 0; i-- {
+		for x.next[i].to != nil && traversed+x.next[i].span <= index+1 {
+			traversed += x.next[i].span
+			x = x.next[i].to
+		}
+	}
+
+	return x.key, x.value, nil
+}
+
+/*
+Iterates through the keys from "from" (inclusive) to "to" (exclusive) in ascending order and executes the given
+function on a reference to a copy of each key and a reference to its value. The value may be modified through
+the reference. Keys must not be added or removed during the iteration
+*/
+func (m *Map[K, V]) Range(from K, to K, do func(*K, *V)) {
+	for n := m.ceiling(&from); n != nil && m.compare(&n.key, &to) < 0; n = n.next[0].to {
+		key := n.key
+		do(&key, &n.value)
+	}
+}
+
+/*
+Iterates through the keys in ascending order and executes the given function on a reference to a copy of each
+key and a reference to its value. The value may be modified through the reference. Keys must not be added or
+removed during the iteration.
+Implements ReadOnlyMapper.ForEach
+*/
+func (m *Map[K, V]) ForEach(do func(*K, *V)) {
+	for n := m.head.next[0].to; n != nil; n = n.next[0].to {
+		key := n.key
+		do(&key, &n.value)
+	}
+}
+
+/*
+Finds the last node of every level whose key is less than the given key and the rank of that node, which is the
+number of keys before it
+*/
+func (m *Map[K, V]) search(key *K, update *[maxLevel]*node[K, V], rank *[maxLevel]int) {
+	x := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		if i < m.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i].to != nil && m.compare(&x.next[i].to.key, key) < 0 {
+			rank[i] += x.next[i].span
+			x = x.next[i].to
+		}
+		update[i] = x
+	}
+}
+
+// returns the first node whose key is greater than or equal to the given key, or nil
+func (m *Map[K, V]) ceiling(key *K) *node[K, V] {
+	x := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for x.next[i].to != nil && m.compare(&x.next[i].to.key, key) < 0 {
+			x = x.next[i].to
+		}
+	}
+
+	return x.next[0].to
+}
+
+/*
+Returns a level between 1 and maxLevel where each level above the first is reached with a probability of 1/4.
+The levels come from a xorshift generator owned by the Map, so they do not depend on the keys
+*/
+func (m *Map[K, V]) randomLevel() int {
+	m.random ^= m.random << 13
+	m.random ^= m.random >> 7
+	m.random ^= m.random << 17
+
+	return levelFromBits(m.random)
+}
+
+func levelFromBits(bits uint64) int {
+	level := 1
+	for bits&3 == 0 && level < maxLevel {
+		level++
+		bits >>= 2
+	}
+
+	return level
+}
+
+func entryOf[K any, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+
+	return n.key, n.value, true
+}
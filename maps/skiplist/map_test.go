@@ -0,0 +1,201 @@
+package skiplist
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/maps"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testMapper[K any, V any](m maps.Mapper[K, V]) {}
+
+func keysOf[V any](m maps.ReadOnlyMapper[int, V]) []int {
+	keys := make([]int, 0, m.Size())
+	m.ForEach(func(key *int, _ *V) {
+		keys = append(keys, *key)
+	})
+
+	return keys
+}
+
+func Test_MapShouldImplementMapper(t *testing.T) {
+	m := New[int, string]()
+
+	testMapper[int, string](&m)
+}
+
+func Test_MapPutGetAndRemove(t *testing.T) {
+	m := New[string, int]()
+
+	goassert.True(t, m.Put("b", 2))
+	goassert.True(t, m.Put("a", 1))
+	goassert.False(t, m.Put("b", 3))
+
+	b, bFound := m.Get("b")
+	_, cFound := m.Get("c")
+
+	goassert.Equal(t, 2, m.Size())
+	goassert.Equal(t, 3, b)
+	goassert.True(t, bFound)
+	goassert.False(t, cFound)
+	goassert.True(t, m.ContainsKey("a"))
+	goassert.True(t, m.Remove("a"))
+	goassert.False(t, m.Remove("a"))
+	goassert.False(t, m.ContainsKey("a"))
+	goassert.Equal(t, 1, m.Size())
+}
+
+func Test_MapForEachShouldIterateInKeyOrder_AndAllowModifyingValues(t *testing.T) {
+	m := New[int, int]()
+	for _, key := range []int{14, 16, 5, 23, 7} {
+		m.Put(key, key)
+	}
+
+	m.ForEach(func(_ *int, value *int) {
+		*value *= 2
+	})
+
+	goassert.DeepEqual(t, []int{5, 7, 14, 16, 23}, keysOf[int](&m))
+	value, _ := m.Get(23)
+	goassert.Equal(t, 46, value)
+}
+
+func Test_MapShouldUseGivenComparator(t *testing.T) {
+	m := NewWithComparator[int, string](comparer.Natural[int]().Reverse())
+	for _, key := range []int{14, 16, 5} {
+		m.Put(key, "")
+	}
+
+	goassert.DeepEqual(t, []int{16, 14, 5}, keysOf[string](&m))
+}
+
+func Test_MapRangeShouldVisitKeysInHalfOpenRange(t *testing.T) {
+	m := New[int, string]()
+	for key := 0; key < 20; key += 2 {
+		m.Put(key, "")
+	}
+
+	keys := make([]int, 0)
+	m.Range(3, 12, func(key *int, _ *string) {
+		keys = append(keys, *key)
+	})
+
+	goassert.DeepEqual(t, []int{4, 6, 8, 10}, keys)
+}
+
+func Test_MapMinMaxCeilingAndFloor(t *testing.T) {
+	m := New[int, string]()
+	m.Put(10, "ten")
+	m.Put(20, "twenty")
+	m.Put(30, "thirty")
+
+	minKey, minValue := m.Min()
+	maxKey, maxValue := m.Max()
+	ceilingKey, _, ceilingFound := m.Ceiling(11)
+	floorKey, _, floorFound := m.Floor(29)
+	_, _, noCeiling := m.Ceiling(31)
+	_, _, noFloor := m.Floor(9)
+	exactFloor, _, _ := m.Floor(20)
+
+	goassert.Equal(t, 10, minKey)
+	goassert.Equal(t, "ten", minValue)
+	goassert.Equal(t, 30, maxKey)
+	goassert.Equal(t, "thirty", maxValue)
+	goassert.Equal(t, 20, ceilingKey)
+	goassert.True(t, ceilingFound)
+	goassert.Equal(t, 20, floorKey)
+	goassert.True(t, floorFound)
+	goassert.False(t, noCeiling)
+	goassert.False(t, noFloor)
+	goassert.Equal(t, 20, exactFloor)
+}
+
+func Test_MapMinAndMaxShouldPanic_GivenEmptyMap(t *testing.T) {
+	m := New[int, string]()
+
+	_, _, err := m.TryMax()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot get Min. Map is empty", func() {
+		m.Min()
+	})
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot get Max. Map is empty", func() {
+		m.Max()
+	})
+}
+
+func Test_MapRankAndAt(t *testing.T) {
+	m := New[int, string]()
+	for _, key := range []int{14, 16, 5, 23, 7} {
+		m.Put(key, "")
+	}
+
+	goassert.Equal(t, 0, m.Rank(5))
+	goassert.Equal(t, 2, m.Rank(14))
+	goassert.Equal(t, 2, m.Rank(10))
+	goassert.Equal(t, 5, m.Rank(100))
+
+	key, _ := m.At(3)
+	goassert.Equal(t, 16, key)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrIndexOutOfRange,
+		"Map.At could not retrieve key because given index 5 is out of range",
+		func() { m.At(5) },
+	)
+}
+
+func Test_MapClear(t *testing.T) {
+	m := New[int, string]()
+	for key := 0; key < 100; key++ {
+		m.Put(key, "")
+	}
+
+	m.Clear()
+	m.Put(1, "")
+
+	goassert.Equal(t, 1, m.Size())
+	goassert.DeepEqual(t, []int{1}, keysOf[string](&m))
+	goassert.Equal(t, 0, m.Rank(1))
+}
+
+func Test_MapShouldMatchSortedModel_GivenRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	m := New[int, int]()
+	model := make(map[int]int)
+
+	for i := 0; i < 20000; i++ {
+		key := random.Intn(2000)
+		switch random.Intn(3) {
+		case 0:
+			_, exists := model[key]
+			goassert.Equal(t, exists, m.Remove(key))
+			delete(model, key)
+		default:
+			_, exists := model[key]
+			goassert.Equal(t, !exists, m.Put(key, i))
+			model[key] = i
+		}
+
+		if i%1000 == 0 {
+			keys := make([]int, 0, len(model))
+			for key := range model {
+				keys = append(keys, key)
+			}
+			sort.Ints(keys)
+
+			goassert.DeepEqual(t, keys, keysOf[int](&m))
+			for index, key := range keys {
+				atKey, atValue := m.At(index)
+				goassert.Equal(t, key, atKey)
+				goassert.Equal(t, model[key], atValue)
+				goassert.Equal(t, index, m.Rank(key))
+			}
+		}
+	}
+}
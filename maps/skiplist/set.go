@@ -0,0 +1,362 @@
+package skiplist
+
+import (
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/set"
+)
+
+/*
+Sorted set backed by a skip list Map. Members are kept in the order of the given Comparator, so the Set can be
+iterated in order, scanned by range and queried by rank. Add, Remove, Contains, Rank and At take O(log n)
+expected time. Sets returned by GetIntersection, GetUnion, GetDifference and GetSymmetricDifference are sorted
+Sets using the same Comparator.
+Implements Seter and Collectioner.
+Set is not thread safe
+*/
+type Set[K comparable] struct {
+	members Map[K, struct{}]
+}
+
+/*
+Creates a new instance of Set with the given elements which orders its members in their natural ascending order
+and returns it
+*/
+func NewSet[K comparer.Ordered](elements ...K) Set[K] {
+	return NewSetWithComparator(comparer.Natural[K](), elements...)
+}
+
+/*
+Creates a new instance of Set with the given elements which orders its members with the given Comparator and
+returns it. The Comparator must only order equal members equally
+*/
+func NewSetWithComparator[K comparable](compare comparer.Comparator[K], elements ...K) Set[K] {
+	s := Set[K]{members: NewWithComparator[K, struct{}](compare)}
+	for _, element := range elements {
+		s.Add(element)
+	}
+
+	return s
+}
+
+/*
+Creates a new instance of Set with the elements of the given collection which orders its members in their
+natural ascending order and returns it
+*/
+func NewSetFromCollection[K comparer.Ordered](c generic.ReadOnlyCollectioner[K]) Set[K] {
+	s := NewSet[K]()
+	c.ForEach(func(element *K) {
+		s.Add(*element)
+	})
+
+	return s
+}
+
+/*
+Returns the number of members of the Set.
+Implements Seter.Size and Collectioner.Size
+*/
+func (s *Set[K]) Size() int {
+	return s.members.Size()
+}
+
+/*
+Returns true if the Set is empty. Otherwise, false.
+Implements Seter.Empty and Collectioner.Empty
+*/
+func (s *Set[K]) Empty() bool {
+	return s.members.Empty()
+}
+
+/*
+Adds the given element to the Set. Returns true if it was not a member yet. Otherwise, false.
+Implements Seter.Add and Collectioner.Add
+*/
+func (s *Set[K]) Add(element K) bool {
+	return s.members.Put(element, struct{}{})
+}
+
+/*
+Removes the given element from the Set. Returns true if it was a member. Otherwise, false.
+Implements Seter.Remove and Collectioner.Remove
+*/
+func (s *Set[K]) Remove(element K) bool {
+	return s.members.Remove(element)
+}
+
+/*
+Returns true when the given element is a member of the Set.
+Implements Seter.Contains and Collectioner.Contains
+*/
+func (s *Set[K]) Contains(element K) bool {
+	return s.members.ContainsKey(element)
+}
+
+/*
+Removes all members from the Set.
+Implements Seter.Clear
+*/
+func (s *Set[K]) Clear() {
+	s.members.Clear()
+}
+
+/*
+Returns the smallest member. Panics if the Set is empty
+*/
+func (s *Set[K]) Min() K {
+	member, _ := s.members.Min()
+	return member
+}
+
+/*
+Returns the smallest member. Returns an error wrapping errors.ErrEmpty if the Set is empty
+*/
+func (s *Set[K]) TryMin() (K, error) {
+	member, _, err := s.members.TryMin()
+	return member, err
+}
+
+/*
+Returns the largest member. Panics if the Set is empty
+*/
+func (s *Set[K]) Max() K {
+	member, _ := s.members.Max()
+	return member
+}
+
+/*
+Returns the largest member. Returns an error wrapping errors.ErrEmpty if the Set is empty
+*/
+func (s *Set[K]) TryMax() (K, error) {
+	member, _, err := s.members.TryMax()
+	return member, err
+}
+
+/*
+Returns the smallest member which is greater than or equal to the given element and true, or the zero value and
+false if there is no such member
+*/
+func (s *Set[K]) Ceiling(element K) (K, bool) {
+	member, _, found := s.members.Ceiling(element)
+	return member, found
+}
+
+/*
+Returns the largest member which is less than or equal to the given element and true, or the zero value and
+false if there is no such member
+*/
+func (s *Set[K]) Floor(element K) (K, bool) {
+	member, _, found := s.members.Floor(element)
+	return member, found
+}
+
+/*
+Returns the number of members which are less than the given element. If the element is a member, the returned
+value is its index in the sorted order of the members
+*/
+func (s *Set[K]) Rank(element K) int {
+	return s.members.Rank(element)
+}
+
+/*
+Returns the member at the given index in the sorted order of the members. Panics if the given index is out of
+range
+*/
+func (s *Set[K]) At(index int) K {
+	member, _ := s.members.At(index)
+	return member
+}
+
+/*
+Returns the member at the given index in the sorted order of the members. Returns an error wrapping
+errors.ErrIndexOutOfRange if the given index is out of range
+*/
+func (s *Set[K]) TryAt(index int) (K, error) {
+	member, _, err := s.members.TryAt(index)
+	return member, err
+}
+
+/*
+Iterates through the members from "from" (inclusive) to "to" (exclusive) in ascending order and executes the
+given function on a reference to a copy of each member
+*/
+func (s *Set[K]) Range(from K, to K, do func(*K)) {
+	s.members.Range(from, to, func(member *K, _ *struct{}) {
+		do(member)
+	})
+}
+
+/*
+Returns true when the given set has the same members as the Set.
+Implements Seter.Equals
+*/
+func (s *Set[K]) Equals(other set.ReadOnlySeter[K]) bool {
+	return s.Size() == other.Size() && s.IsSubsetOf(other)
+}
+
+/*
+Returns a hash code of the members of the Set which does not depend on the iteration order, so it matches the
+hash code of any other set with the same members.
+Implements Seter.HashCode
+*/
+func (s *Set[K]) HashCode(hash func(*K) uint64) uint64 {
+	return generic.UnorderedHashCode[K](s, hash)
+}
+
+/*
+Returns true when the given set has common members with the Set.
+Implements Seter.Intersects
+*/
+func (s *Set[K]) Intersects(other set.ReadOnlySeter[K]) bool {
+	intersects := false
+	s.ForEach(func(member *K) {
+		if !intersects && other.Contains(*member) {
+			intersects = true
+		}
+	})
+
+	return intersects
+}
+
+/*
+Returns a new sorted Set with the common members between the Set and the given set.
+Implements Seter.GetIntersection
+*/
+func (s *Set[K]) GetIntersection(other set.ReadOnlySeter[K]) set.Seter[K] {
+	intersection := s.empty()
+	s.ForEach(func(member *K) {
+		if other.Contains(*member) {
+			intersection.Add(*member)
+		}
+	})
+
+	return intersection
+}
+
+/*
+Returns a new sorted Set with all the members of both the Set and the given set.
+Implements Seter.GetUnion
+*/
+func (s *Set[K]) GetUnion(other set.ReadOnlySeter[K]) set.Seter[K] {
+	union := s.empty()
+	s.ForEach(func(member *K) {
+		union.Add(*member)
+	})
+	other.ForEach(func(member *K) {
+		union.Add(*member)
+	})
+
+	return union
+}
+
+/*
+Returns a new sorted Set with the members of the Set which are not members of the given set.
+Implements Seter.GetDifference
+*/
+func (s *Set[K]) GetDifference(other set.ReadOnlySeter[K]) set.Seter[K] {
+	difference := s.empty()
+	s.ForEach(func(member *K) {
+		if !other.Contains(*member) {
+			difference.Add(*member)
+		}
+	})
+
+	return difference
+}
+
+/*
+Returns a new sorted Set with the members which are members of exactly one of the Set and the given set.
+Implements Seter.GetSymmetricDifference
+*/
+func (s *Set[K]) GetSymmetricDifference(other set.ReadOnlySeter[K]) set.Seter[K] {
+	difference := s.empty()
+	s.ForEach(func(member *K) {
+		if !other.Contains(*member) {
+			difference.Add(*member)
+		}
+	})
+	other.ForEach(func(member *K) {
+		if !s.Contains(*member) {
+			difference.Add(*member)
+		}
+	})
+
+	return difference
+}
+
+/*
+Returns true when the given set has no common members with the Set.
+Implements Seter.IsDisjoint
+*/
+func (s *Set[K]) IsDisjoint(other set.ReadOnlySeter[K]) bool {
+	return !s.Intersects(other)
+}
+
+/*
+Returns true if the Set contains all the members of the given set.
+Implements Seter.IsSupersetOf
+*/
+func (s *Set[K]) IsSupersetOf(other set.ReadOnlySeter[K]) bool {
+	if s.Size() < other.Size() {
+		return false
+	}
+
+	isSuperset := true
+	other.ForEach(func(member *K) {
+		if isSuperset && !s.Contains(*member) {
+			isSuperset = false
+		}
+	})
+
+	return isSuperset
+}
+
+/*
+Returns true if the Set contains all the members of the given set and at least one other member.
+Implements Seter.IsProperSupersetOf
+*/
+func (s *Set[K]) IsProperSupersetOf(other set.ReadOnlySeter[K]) bool {
+	return s.Size() > other.Size() && s.IsSupersetOf(other)
+}
+
+/*
+Returns true if the given set has all the members of the Set.
+Implements Seter.IsSubsetOf
+*/
+func (s *Set[K]) IsSubsetOf(other set.ReadOnlySeter[K]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+
+	isSubset := true
+	s.ForEach(func(member *K) {
+		if isSubset && !other.Contains(*member) {
+			isSubset = false
+		}
+	})
+
+	return isSubset
+}
+
+/*
+Returns true if the given set has all the members of the Set and at least one other member.
+Implements Seter.IsProperSubsetOf
+*/
+func (s *Set[K]) IsProperSubsetOf(other set.ReadOnlySeter[K]) bool {
+	return s.Size() < other.Size() && s.IsSubsetOf(other)
+}
+
+/*
+Iterates through the members of the Set in ascending order and executes the given function on a reference to a
+copy of each member.
+Implements Seter.ForEach and Collectioner.ForEach
+*/
+func (s *Set[K]) ForEach(do func(*K)) {
+	s.members.ForEach(func(member *K, _ *struct{}) {
+		do(member)
+	})
+}
+
+func (s *Set[K]) empty() *Set[K] {
+	return &Set[K]{members: NewWithComparator[K, struct{}](s.members.compare)}
+}
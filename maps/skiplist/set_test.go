@@ -0,0 +1,112 @@
+package skiplist
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/list/arraylist"
+	"github.com/golanglibs/gocollections/set"
+	"github.com/golanglibs/gocollections/set/hashset"
+)
+
+func testSeter[K comparable](s set.Seter[K]) {}
+
+func testCollectioner[T any](c generic.Collectioner[T]) {}
+
+func membersOf(s set.ReadOnlySeter[int]) []int {
+	members := make([]int, 0, s.Size())
+	s.ForEach(func(member *int) {
+		members = append(members, *member)
+	})
+
+	return members
+}
+
+func Test_SetShouldImplementSeterAndCollectioner(t *testing.T) {
+	s := NewSet[int]()
+
+	testSeter[int](&s)
+	testCollectioner[int](&s)
+}
+
+func Test_SetShouldKeepMembersSorted(t *testing.T) {
+	s := NewSet(14, 16, 5, 23, 7, 5)
+
+	goassert.False(t, s.Add(14))
+	goassert.True(t, s.Add(1))
+	goassert.True(t, s.Remove(16))
+	goassert.False(t, s.Remove(16))
+
+	goassert.DeepEqual(t, []int{1, 5, 7, 14, 23}, membersOf(&s))
+	goassert.True(t, s.Contains(7))
+	goassert.False(t, s.Contains(16))
+}
+
+func Test_NewSetFromCollection(t *testing.T) {
+	l := arraylist.New(3, 1, 2, 3)
+
+	s := NewSetFromCollection[int](&l)
+
+	goassert.DeepEqual(t, []int{1, 2, 3}, membersOf(&s))
+}
+
+func Test_SetOrderedQueries(t *testing.T) {
+	s := NewSetWithComparator(comparer.Natural[int]().Reverse(), 10, 20, 30)
+
+	ceiling, _ := s.Ceiling(25)
+	floor, _ := s.Floor(25)
+	ranged := make([]int, 0)
+	s.Range(30, 10, func(member *int) {
+		ranged = append(ranged, *member)
+	})
+
+	goassert.Equal(t, 30, s.Min())
+	goassert.Equal(t, 10, s.Max())
+	goassert.Equal(t, 20, ceiling)
+	goassert.Equal(t, 30, floor)
+	goassert.Equal(t, 1, s.Rank(20))
+	goassert.Equal(t, 10, s.At(2))
+	goassert.DeepEqual(t, []int{30, 20}, ranged)
+}
+
+func Test_SetOperationsShouldReturnSortedSets(t *testing.T) {
+	s := NewSet(1, 2, 3, 4)
+	other := hashset.New(3, 4, 5)
+
+	union := s.GetUnion(&other)
+	intersection := s.GetIntersection(&other)
+	difference := s.GetDifference(&other)
+	symmetricDifference := s.GetSymmetricDifference(&other)
+
+	goassert.DeepEqual(t, []int{1, 2, 3, 4, 5}, membersOf(union))
+	goassert.DeepEqual(t, []int{3, 4}, membersOf(intersection))
+	goassert.DeepEqual(t, []int{1, 2}, membersOf(difference))
+	goassert.DeepEqual(t, []int{1, 2, 5}, membersOf(symmetricDifference))
+	goassert.True(t, s.Intersects(&other))
+	goassert.False(t, s.IsDisjoint(&other))
+}
+
+func Test_SetComparisonsWithOtherSets(t *testing.T) {
+	s := NewSet(1, 2, 3)
+	same := hashset.New(3, 2, 1)
+	superset := hashset.New(1, 2, 3, 4)
+
+	goassert.True(t, s.Equals(&same))
+	goassert.Equal(t, same.HashCode(comparer.DefaultHash[int]), s.HashCode(comparer.DefaultHash[int]))
+	goassert.True(t, s.IsSubsetOf(&superset))
+	goassert.True(t, s.IsProperSubsetOf(&superset))
+	goassert.False(t, s.IsProperSubsetOf(&same))
+	goassert.True(t, s.IsSupersetOf(&same))
+	goassert.False(t, s.IsProperSupersetOf(&same))
+}
+
+func Test_SetClear(t *testing.T) {
+	s := NewSet(1, 2, 3)
+
+	s.Clear()
+
+	goassert.True(t, s.Empty())
+	goassert.Equal(t, 0, s.Size())
+}
@@ -0,0 +1,265 @@
+package indexedpriorityqueue
+
+import (
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Indexed binary heap. Every value is stored under a unique key, and the position of each key in the heap is
+tracked, so that the value of a key can be updated (for example to decrease the distance of a vertex in
+Dijkstra's algorithm) or removed in O(log n) time, and the existence of a key can be checked in O(1) time.
+IndexedPriorityQueue is not thread safe
+*/
+type IndexedPriorityQueue[K comparable, V any] struct {
+	compare   func(*V, *V) bool
+	heap      []entry[K, V]
+	positions map[K]int
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+/*
+Initializes a new instance of empty IndexedPriorityQueue and returns it. "compare" function must be passed in
+order to compare values. If the "compare(v0, v1)" returns true, it means "v0" will have higher priority than
+"v1" and its key will be dequeued earlier than the key of "v1"
+*/
+func New[K comparable, V any](compare func(*V, *V) bool) IndexedPriorityQueue[K, V] {
+	return IndexedPriorityQueue[K, V]{
+		compare:   compare,
+		heap:      []entry[K, V]{{}},
+		positions: make(map[K]int),
+	}
+}
+
+/*
+Returns the number of keys in the IndexedPriorityQueue
+*/
+func (pq *IndexedPriorityQueue[K, V]) Size() int {
+	return len(pq.positions)
+}
+
+/*
+Returns true if the IndexedPriorityQueue is empty. Otherwise, false
+*/
+func (pq *IndexedPriorityQueue[K, V]) Empty() bool {
+	return len(pq.positions) == 0
+}
+
+/*
+Pushes the given key with the given value into the IndexedPriorityQueue. If the key already exists, its value
+is replaced by the given value instead. Time complexity is O(log n)
+*/
+func (pq *IndexedPriorityQueue[K, V]) Enqueue(key K, value V) {
+	if _, exists := pq.positions[key]; exists {
+		pq.update(key, value)
+		return
+	}
+
+	pq.heap = append(pq.heap, entry[K, V]{key: key, value: value})
+	i := len(pq.heap) - 1
+	pq.positions[key] = i
+	pq.siftUp(i)
+}
+
+/*
+Removes the key with the highest priority. Panics if the IndexedPriorityQueue is empty
+*/
+func (pq *IndexedPriorityQueue[K, V]) Dequeue() {
+	if _, _, err := pq.TryDequeue(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the key with the highest priority and returns it with its value. Returns an error wrapping
+errors.ErrEmpty if the IndexedPriorityQueue is empty
+*/
+func (pq *IndexedPriorityQueue[K, V]) TryDequeue() (K, V, error) {
+	if pq.Empty() {
+		var key K
+		var value V
+		return key, value, errors.Newf(errors.ErrEmpty, "Cannot Dequeue. IndexedPriorityQueue is empty")
+	}
+
+	top := pq.heap[1]
+	pq.removeAt(1)
+
+	return top.key, top.value, nil
+}
+
+/*
+Returns the key with the highest priority and its value without removing them. Panics if the
+IndexedPriorityQueue is empty
+*/
+func (pq *IndexedPriorityQueue[K, V]) Peek() (K, V) {
+	key, value, err := pq.TryPeek()
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+/*
+Returns the key with the highest priority and its value without removing them. Returns an error wrapping
+errors.ErrEmpty if the IndexedPriorityQueue is empty
+*/
+func (pq *IndexedPriorityQueue[K, V]) TryPeek() (K, V, error) {
+	if pq.Empty() {
+		var key K
+		var value V
+		return key, value, errors.Newf(errors.ErrEmpty, "Cannot Peek. IndexedPriorityQueue is empty")
+	}
+
+	return pq.heap[1].key, pq.heap[1].value, nil
+}
+
+/*
+Returns true if the given key exists in the IndexedPriorityQueue. Time complexity is O(1)
+*/
+func (pq *IndexedPriorityQueue[K, V]) ContainsKey(key K) bool {
+	_, exists := pq.positions[key]
+	return exists
+}
+
+/*
+Returns the value of the given key and true, or the zero value and false if the key does not exist. Time
+complexity is O(1)
+*/
+func (pq *IndexedPriorityQueue[K, V]) Get(key K) (V, bool) {
+	i, exists := pq.positions[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+
+	return pq.heap[i].value, true
+}
+
+/*
+Replaces the value of the given key with the given value and moves the key to its new position, whether its
+priority increased or decreased. Panics if the key does not exist. Time complexity is O(log n)
+*/
+func (pq *IndexedPriorityQueue[K, V]) Update(key K, value V) {
+	if err := pq.TryUpdate(key, value); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Replaces the value of the given key with the given value and moves the key to its new position. Returns an
+error wrapping errors.ErrKeyNotFound if the key does not exist
+*/
+func (pq *IndexedPriorityQueue[K, V]) TryUpdate(key K, value V) error {
+	if _, exists := pq.positions[key]; !exists {
+		return errors.Newf(errors.ErrKeyNotFound, "Cannot Update. Key %v does not exist", key)
+	}
+
+	pq.update(key, value)
+	return nil
+}
+
+func (pq *IndexedPriorityQueue[K, V]) update(key K, value V) {
+	i := pq.positions[key]
+	pq.heap[i].value = value
+	pq.fix(i)
+}
+
+/*
+Removes the given key with its value. Returns true if the key existed. Otherwise, false. Time complexity is
+O(log n)
+*/
+func (pq *IndexedPriorityQueue[K, V]) RemoveKey(key K) bool {
+	i, exists := pq.positions[key]
+	if !exists {
+		return false
+	}
+
+	pq.removeAt(i)
+	return true
+}
+
+/*
+Empties the IndexedPriorityQueue
+*/
+func (pq *IndexedPriorityQueue[K, V]) Clear() {
+	pq.heap = pq.heap[:1]
+	pq.positions = make(map[K]int)
+}
+
+/*
+Iterates through each key in heap order and executes the given function on references to copies of the key and
+its value. Use Update to change the value of a key
+*/
+func (pq *IndexedPriorityQueue[K, V]) ForEach(do func(*K, *V)) {
+	for i := 1; i < len(pq.heap); i++ {
+		key, value := pq.heap[i].key, pq.heap[i].value
+		do(&key, &value)
+	}
+}
+
+func (pq *IndexedPriorityQueue[K, V]) removeAt(i int) {
+	last := len(pq.heap) - 1
+	delete(pq.positions, pq.heap[i].key)
+	if i != last {
+		pq.heap[i] = pq.heap[last]
+		pq.positions[pq.heap[i].key] = i
+	}
+
+	// the removed entry is cleared so that its key and value can be garbage collected
+	pq.heap[last] = entry[K, V]{}
+	pq.heap = pq.heap[:last]
+
+	if i != last {
+		pq.fix(i)
+	}
+}
+
+// restores the heap order after the value at the given position has changed
+func (pq *IndexedPriorityQueue[K, V]) fix(i int) {
+	if !pq.siftUp(i) {
+		pq.siftDown(i)
+	}
+}
+
+func (pq *IndexedPriorityQueue[K, V]) siftUp(i int) bool {
+	moved := false
+	for i > 1 {
+		parent := i >> 1
+		if !pq.compare(&pq.heap[i].value, &pq.heap[parent].value) {
+			break
+		}
+
+		pq.swap(i, parent)
+		i = parent
+		moved = true
+	}
+
+	return moved
+}
+
+func (pq *IndexedPriorityQueue[K, V]) siftDown(i int) {
+	size := len(pq.heap) - 1
+	for (i << 1) <= size {
+		child := i << 1
+		if child+1 <= size && pq.compare(&pq.heap[child+1].value, &pq.heap[child].value) {
+			child++
+		}
+
+		if !pq.compare(&pq.heap[child].value, &pq.heap[i].value) {
+			break
+		}
+
+		pq.swap(i, child)
+		i = child
+	}
+}
+
+func (pq *IndexedPriorityQueue[K, V]) swap(i int, j int) {
+	pq.heap[i], pq.heap[j] = pq.heap[j], pq.heap[i]
+	pq.positions[pq.heap[i].key] = i
+	pq.positions[pq.heap[j].key] = j
+}
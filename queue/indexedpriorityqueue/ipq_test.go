@@ -0,0 +1,213 @@
+package indexedpriorityqueue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func less(a *int, b *int) bool {
+	return *a < *b
+}
+
+func drain(pq *IndexedPriorityQueue[string, int]) []string {
+	keys := make([]string, 0, pq.Size())
+	for !pq.Empty() {
+		key, _, _ := pq.TryDequeue()
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// verifies that every parent has a higher priority than its children and that every position is tracked
+func verifyHeap[K comparable, V any](t *testing.T, pq *IndexedPriorityQueue[K, V]) {
+	t.Helper()
+
+	goassert.Equal(t, len(pq.heap)-1, len(pq.positions))
+	for i := 1; i < len(pq.heap); i++ {
+		goassert.Equal(t, i, pq.positions[pq.heap[i].key])
+		if i > 1 {
+			goassert.False(t, pq.compare(&pq.heap[i].value, &pq.heap[i>>1].value))
+		}
+	}
+}
+
+func Test_NewShouldCreateEmptyIndexedPriorityQueue(t *testing.T) {
+	pq := New[string](less)
+
+	goassert.Equal(t, 0, pq.Size())
+	goassert.True(t, pq.Empty())
+	goassert.False(t, pq.ContainsKey("a"))
+}
+
+func Test_IndexedPriorityQueueShouldDequeueKeysInPriorityOrder(t *testing.T) {
+	pq := New[string](less)
+	pq.Enqueue("c", 3)
+	pq.Enqueue("a", 1)
+	pq.Enqueue("d", 4)
+	pq.Enqueue("b", 2)
+
+	key, value := pq.Peek()
+
+	goassert.Equal(t, "a", key)
+	goassert.Equal(t, 1, value)
+	goassert.Equal(t, 4, pq.Size())
+	goassert.DeepEqual(t, []string{"a", "b", "c", "d"}, drain(&pq))
+}
+
+func Test_EnqueueShouldUpdateValue_GivenKeyExists(t *testing.T) {
+	pq := New[string](less)
+	pq.Enqueue("a", 1)
+	pq.Enqueue("b", 2)
+
+	pq.Enqueue("a", 3)
+
+	value, found := pq.Get("a")
+	goassert.Equal(t, 3, value)
+	goassert.True(t, found)
+	goassert.Equal(t, 2, pq.Size())
+	goassert.DeepEqual(t, []string{"b", "a"}, drain(&pq))
+}
+
+func Test_UpdateShouldMoveKey_GivenPriorityIncreasesOrDecreases(t *testing.T) {
+	pq := New[string](less)
+	for i, key := range []string{"a", "b", "c", "d", "e", "f"} {
+		pq.Enqueue(key, i*10)
+	}
+
+	pq.Update("e", -1)
+	pq.Update("a", 100)
+	pq.Update("c", 25)
+	verifyHeap(t, &pq)
+
+	goassert.DeepEqual(t, []string{"e", "b", "c", "d", "f", "a"}, drain(&pq))
+}
+
+func Test_UpdateShouldPanic_GivenKeyDoesNotExist(t *testing.T) {
+	pq := New[string](less)
+
+	err := pq.TryUpdate("a", 1)
+
+	testhelpers.ErrorIs(t, errors.ErrKeyNotFound, err)
+	testhelpers.PanicWithErrorIs(t, errors.ErrKeyNotFound, "Cannot Update. Key a does not exist", func() {
+		pq.Update("a", 1)
+	})
+}
+
+func Test_RemoveKey(t *testing.T) {
+	pq := New[string](less)
+	for i, key := range []string{"a", "b", "c", "d", "e", "f"} {
+		pq.Enqueue(key, i)
+	}
+
+	goassert.True(t, pq.RemoveKey("b"))
+	goassert.True(t, pq.RemoveKey("f"))
+	goassert.False(t, pq.RemoveKey("b"))
+	goassert.False(t, pq.ContainsKey("b"))
+	verifyHeap(t, &pq)
+
+	goassert.DeepEqual(t, []string{"a", "c", "d", "e"}, drain(&pq))
+}
+
+func Test_DequeueAndPeekShouldPanic_GivenIndexedPriorityQueueIsEmpty(t *testing.T) {
+	pq := New[string](less)
+
+	_, _, dequeueErr := pq.TryDequeue()
+	_, _, peekErr := pq.TryPeek()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, dequeueErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, peekErr)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot Dequeue. IndexedPriorityQueue is empty", func() {
+		pq.Dequeue()
+	})
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot Peek. IndexedPriorityQueue is empty", func() {
+		pq.Peek()
+	})
+}
+
+func Test_ClearAndForEach(t *testing.T) {
+	pq := New[string](less)
+	pq.Enqueue("a", 1)
+	pq.Enqueue("b", 2)
+
+	keys := make([]string, 0)
+	pq.ForEach(func(key *string, value *int) {
+		keys = append(keys, *key)
+		*value = 100
+	})
+	sort.Strings(keys)
+
+	goassert.DeepEqual(t, []string{"a", "b"}, keys)
+	goassert.DeepEqual(t, []string{"a", "b"}, drain(&pq))
+
+	pq.Enqueue("c", 3)
+	pq.Clear()
+
+	goassert.True(t, pq.Empty())
+	goassert.False(t, pq.ContainsKey("c"))
+}
+
+func Test_IndexedPriorityQueueShouldComputeShortestPaths(t *testing.T) {
+	edges := map[int]map[int]int{
+		0: {1: 4, 2: 1},
+		1: {3: 1},
+		2: {1: 2, 3: 5},
+		3: {4: 3},
+	}
+	distances := map[int]int{0: 0}
+
+	pq := New[int](less)
+	pq.Enqueue(0, 0)
+	for !pq.Empty() {
+		vertex, distance, _ := pq.TryDequeue()
+		for next, weight := range edges[vertex] {
+			if known, visited := distances[next]; !visited || distance+weight < known {
+				distances[next] = distance + weight
+				pq.Enqueue(next, distance+weight)
+			}
+		}
+	}
+
+	goassert.DeepEqual(t, map[int]int{0: 0, 1: 3, 2: 1, 3: 4, 4: 7}, distances)
+}
+
+func Test_IndexedPriorityQueueShouldKeepHeapOrder_GivenRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	pq := New[int](less)
+	model := make(map[int]int)
+
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(100)
+		switch random.Intn(4) {
+		case 0:
+			pq.RemoveKey(key)
+			delete(model, key)
+		case 1:
+			if !pq.Empty() {
+				key, value, _ := pq.TryDequeue()
+				for _, other := range model {
+					goassert.True(t, value <= other)
+				}
+				goassert.Equal(t, model[key], value)
+				delete(model, key)
+			}
+		default:
+			value := random.Intn(1000)
+			pq.Enqueue(key, value)
+			model[key] = value
+		}
+	}
+
+	verifyHeap(t, &pq)
+	goassert.Equal(t, len(model), pq.Size())
+	for key, value := range model {
+		actual, found := pq.Get(key)
+		goassert.True(t, found)
+		goassert.Equal(t, value, actual)
+	}
+}
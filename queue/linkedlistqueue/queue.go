@@ -1,8 +1,10 @@
 package linkedlistqueue
 
 import (
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/list/doublylinkedlist"
+	"github.com/golanglibs/gocollections/queue"
 )
 
 /*
@@ -92,11 +94,46 @@ Removes the element at the front of the queue. Panics if Queue is empty.
 Implements Queuer.Dequeue
 */
 func (q *Queue[T]) Dequeue() {
+	if _, err := q.TryDequeue(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the element at the front of the queue and returns it. Returns an error wrapping errors.ErrEmpty if
+Queue is empty.
+Implements Queuer.TryDequeue
+*/
+func (q *Queue[T]) TryDequeue() (T, error) {
 	if q.container.Empty() {
-		panic("Queue.Dequeue failed because queue is empty")
+		var zero T
+		return zero, errors.Newf(errors.ErrEmpty, "Queue.Dequeue failed because queue is empty")
 	}
 
+	element := *q.container.Front()
 	q.container.RemoveFront()
+
+	return element, nil
+}
+
+/*
+Removes the element at the front of the Queue and returns it along with true. Returns the zero value and
+false if the Queue is empty.
+Implements Queuer.DequeueValue
+*/
+func (q *Queue[T]) DequeueValue() (T, bool) {
+	element, err := q.TryDequeue()
+
+	return element, err == nil
+}
+
+/*
+Removes up to n elements from the front of the Queue and returns them in the order they were dequeued.
+Returns fewer than n elements if the Queue runs out of elements, and an empty slice if n is not positive.
+Implements Queuer.DequeueN
+*/
+func (q *Queue[T]) DequeueN(n int) []T {
+	return generic.TakeN(n, q.Size(), q.TryDequeue)
 }
 
 /*
@@ -104,11 +141,25 @@ Returns a reference to the element at the front of the queue without removing it
 Implements Queuer.Peek
 */
 func (q *Queue[T]) Peek() *T {
+	element, err := q.TryPeek()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the element at the front of the queue without removing it. Returns an error wrapping
+errors.ErrEmpty if Queue is empty.
+Implements Queuer.TryPeek
+*/
+func (q *Queue[T]) TryPeek() (*T, error) {
 	if q.container.Empty() {
-		panic("Queue.Peek failed because queue is empty")
+		return nil, errors.Newf(errors.ErrEmpty, "Queue.Peek failed because queue is empty")
 	}
 
-	return q.container.Front()
+	return q.container.Front(), nil
 }
 
 /*
@@ -129,6 +180,14 @@ func (q *Queue[T]) Remove(element T) bool {
 	return q.container.Remove(element)
 }
 
+/*
+Removes the the given element and returns true if present in the Queue. Returns an error wrapping
+errors.ErrNoEqualityComparer if the equality comparer is not set
+*/
+func (q *Queue[T]) TryRemove(element T) (bool, error) {
+	return q.container.TryRemove(element)
+}
+
 /*
 Returns true if the given element exists in the Queue. Returns false otherwise.
 Implements Queuer.Contains and Collectioner.Contains
@@ -137,6 +196,33 @@ func (q *Queue[T]) Contains(element T) bool {
 	return q.container.Contains(element)
 }
 
+/*
+Returns true if the given element exists in the Queue. Returns an error wrapping errors.ErrNoEqualityComparer
+if the equality comparer is not set.
+Implements Queuer.TryContains
+*/
+func (q *Queue[T]) TryContains(element T) (bool, error) {
+	return q.container.TryContains(element)
+}
+
+/*
+Returns true if the given queue has the same size as the Queue and would dequeue equal elements in the same
+order. Equality is determined by the equality comparer. Panics if the equality comparer is not set.
+Implements Queuer.Equals
+*/
+func (q *Queue[T]) Equals(other queue.ReadOnlyQueuer[T]) bool {
+	return q.container.EqualsInOrder(queue.DequeueOrder[T](other))
+}
+
+/*
+Returns a hash code which depends on the elements of the Queue and their order. Elements are hashed with the
+given function, which must return equal hashes for elements the equality comparer considers equal.
+Implements Queuer.HashCode
+*/
+func (q *Queue[T]) HashCode(hash func(*T) uint64) uint64 {
+	return q.container.HashCode(hash)
+}
+
 /*
 Empties the Queue.
 Implements Queuer.Clear and Collectioner.Clear
@@ -0,0 +1,53 @@
+package linkedlistqueue
+
+import "github.com/golanglibs/gocollections/codec"
+
+/*
+Encodes the Queue into a binary payload made of a versioned header (collection kind and element count)
+followed by the gob encoding of its elements from front to back.
+Implements encoding.BinaryMarshaler
+*/
+func (q Queue[T]) MarshalBinary() ([]byte, error) {
+	elements := make([]T, 0, q.container.Size())
+	q.container.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return codec.MarshalElements(codec.KindLinkedListQueue, elements)
+}
+
+/*
+Decodes a payload produced by Queue.MarshalBinary and replaces the elements of the Queue with the decoded
+elements. Returns an error if the payload is corrupted or was produced by a different kind of collection.
+The equality comparer of the Queue is left untouched.
+Implements encoding.BinaryUnmarshaler
+*/
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	elements, err := codec.UnmarshalElements[T](codec.KindLinkedListQueue, data)
+	if err != nil {
+		return err
+	}
+
+	q.container.Clear()
+	for _, element := range elements {
+		q.container.Add(element)
+	}
+
+	return nil
+}
+
+/*
+Encodes the Queue in the same format as Queue.MarshalBinary.
+Implements gob.GobEncoder
+*/
+func (q Queue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+/*
+Decodes a payload produced by Queue.GobEncode.
+Implements gob.GobDecoder
+*/
+func (q *Queue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
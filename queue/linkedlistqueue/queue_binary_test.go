@@ -0,0 +1,48 @@
+package linkedlistqueue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/codec"
+)
+
+func Test_BinaryRoundTripShouldPreserveOrder_AndEqualityComparer(t *testing.T) {
+	original := New(10, 16, 14)
+
+	data, err := original.MarshalBinary()
+	goassert.Nil(t, err)
+
+	decoded := New(3, 5)
+	err = decoded.UnmarshalBinary(data)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 3, decoded.Size())
+	goassert.True(t, decoded.Contains(14))
+	goassert.Equal(t, 10, *decoded.Peek())
+}
+
+func Test_UnmarshalBinaryShouldReturnErrKindMismatch_GivenPayloadOfDoublyLinkedList(t *testing.T) {
+	data, _ := codec.MarshalElements(codec.KindDoublyLinkedList, []int{10})
+	queue := New[int]()
+
+	err := queue.UnmarshalBinary(data)
+
+	goassert.True(t, errors.Is(err, codec.ErrKindMismatch))
+}
+
+func Test_GobShouldDecodeIntoZeroValueQueue(t *testing.T) {
+	original := New(10, 16)
+
+	var buffer bytes.Buffer
+	goassert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded Queue[int]
+	goassert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+
+	goassert.Equal(t, 2, decoded.Size())
+	goassert.Equal(t, 10, *decoded.Peek())
+}
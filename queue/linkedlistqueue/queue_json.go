@@ -0,0 +1,18 @@
+package linkedlistqueue
+
+/*
+Encodes the Queue as a JSON array of its elements from front to back.
+Implements json.Marshaler
+*/
+func (q Queue[T]) MarshalJSON() ([]byte, error) {
+	return q.container.MarshalJSON()
+}
+
+/*
+Decodes the given JSON array and replaces the elements of the Queue with the decoded elements, where the
+first element of the array becomes the front of the Queue. The equality comparer is left untouched.
+Implements json.Unmarshaler
+*/
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	return q.container.UnmarshalJSON(data)
+}
@@ -0,0 +1,46 @@
+package linkedlistqueue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_MarshalJSONShouldEncodeElementsFromFrontToBack(t *testing.T) {
+	queue := New(10, 16, 14)
+	queue.Dequeue()
+
+	data, err := json.Marshal(queue)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[16,14]", string(data))
+}
+
+func Test_UnmarshalJSONShouldReplaceElements_WithFirstElementAtFront(t *testing.T) {
+	queue := New(3)
+
+	err := json.Unmarshal([]byte("[10,16,14]"), &queue)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 3, queue.Size())
+	goassert.Equal(t, 10, *queue.Peek())
+	goassert.True(t, queue.Contains(14))
+}
+
+func Test_JSONRoundTripShouldPreserveOrder(t *testing.T) {
+	original := New("a", "b", "c")
+
+	data, err := json.Marshal(original)
+	goassert.Nil(t, err)
+
+	decoded := New[string]()
+	err = json.Unmarshal(data, &decoded)
+
+	goassert.Nil(t, err)
+	for _, expected := range []string{"a", "b", "c"} {
+		goassert.Equal(t, expected, *decoded.Peek())
+		decoded.Dequeue()
+	}
+	goassert.True(t, decoded.Empty())
+}
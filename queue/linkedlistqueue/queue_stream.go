@@ -0,0 +1,30 @@
+package linkedlistqueue
+
+import (
+	"io"
+
+	"github.com/golanglibs/gocollections/codec"
+)
+
+/*
+Streams the Queue to the given writer without building the whole payload in memory. Writes a versioned header
+(collection kind and element count) followed by one length-prefixed frame per element from front to back,
+where each frame is produced by the given element encoder. Returns the number of bytes written
+*/
+func (q *Queue[T]) EncodeTo(w io.Writer, encodeElement codec.ElementEncoder[T]) (int64, error) {
+	return codec.WriteStream(w, codec.KindLinkedListQueue, q.container.Size(), q.container.ForEach, encodeElement)
+}
+
+/*
+Reads a stream written by Queue.EncodeTo from the given reader and replaces the elements of the Queue with the
+elements decoded by the given element decoder, where the first decoded element becomes the front. Elements are
+enqueued as they are decoded, so if an error is returned the Queue holds the elements decoded before the
+error. The equality comparer is left untouched. Returns the number of bytes read
+*/
+func (q *Queue[T]) DecodeFrom(r io.Reader, decodeElement codec.ElementDecoder[T]) (int64, error) {
+	q.container.Clear()
+
+	return codec.ReadStream(r, codec.KindLinkedListQueue, decodeElement, func(element T) {
+		q.container.Add(element)
+	})
+}
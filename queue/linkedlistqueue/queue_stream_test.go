@@ -0,0 +1,25 @@
+package linkedlistqueue
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_StreamRoundTripShouldPreserveOrderFromFrontToBack(t *testing.T) {
+	original := New(10, 16, 14)
+	var buffer bytes.Buffer
+
+	written, err := original.EncodeTo(&buffer, testhelpers.EncodeInt)
+	goassert.Nil(t, err)
+
+	var decoded Queue[int]
+	read, err := decoded.DecodeFrom(&buffer, testhelpers.DecodeInt)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, written, read)
+	goassert.Equal(t, 3, decoded.Size())
+	goassert.Equal(t, 10, *decoded.Peek())
+}
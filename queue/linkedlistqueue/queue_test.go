@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/queue"
+	"github.com/golanglibs/gocollections/queue/priorityqueue"
 	"github.com/golanglibs/gocollections/testhelpers"
 )
 
@@ -130,7 +133,7 @@ func Test_DequeueShouldPanic_IfQueueIsEmpty(t *testing.T) {
 	queue := New[int]()
 
 	expectedError := "Queue.Dequeue failed because queue is empty"
-	goassert.PanicWithError(t, expectedError, func() { queue.Dequeue() })
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, expectedError, func() { queue.Dequeue() })
 }
 
 func Test_PeekShouldReturnElementAtFrontOfQueue_IfQueueIsNotEmpty(t *testing.T) {
@@ -148,7 +151,7 @@ func Test_PeekShouldPanic_IfQueueIsEmpty(t *testing.T) {
 	queue := New[int]()
 
 	expectedError := "Queue.Peek failed because queue is empty"
-	goassert.PanicWithError(t, expectedError, func() { queue.Peek() })
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, expectedError, func() { queue.Peek() })
 }
 
 func Test_AddShouldReturnTrueAndAddGivenElementToBackOfQueue(t *testing.T) {
@@ -229,3 +232,144 @@ func Test_LinkedListQueueShouldImplementCollectioner(t *testing.T) {
 	queue := New[int]()
 	testCollectioner[int](&queue)
 }
+
+func Test_TryDequeueShouldRemoveAndReturnFrontElement_IfQueueIsNotEmpty(t *testing.T) {
+	queue := New(10, 16, 14)
+
+	element, err := queue.TryDequeue()
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 10, element)
+	verifyQueue(t, []int{16, 14}, &queue)
+}
+
+func Test_TryDequeueShouldReturnErrEmpty_IfQueueIsEmpty(t *testing.T) {
+	queue := New[int]()
+
+	element, err := queue.TryDequeue()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	goassert.Equal(t, 0, element)
+}
+
+func Test_TryPeekShouldReturnFrontElement_IfQueueIsNotEmpty(t *testing.T) {
+	queue := New(10, 16, 14)
+
+	element, err := queue.TryPeek()
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 10, *element)
+	verifyQueue(t, []int{10, 16, 14}, &queue)
+}
+
+func Test_TryPeekShouldReturnErrEmpty_IfQueueIsEmpty(t *testing.T) {
+	queue := New[int]()
+
+	element, err := queue.TryPeek()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	goassert.Nil(t, element)
+}
+
+func Test_TryContainsShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	queue := NewOfAny(testhelpers.NewMockStruct(10))
+
+	contains, err := queue.TryContains(testhelpers.NewMockStruct(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	goassert.False(t, contains)
+}
+
+func Test_TryRemoveShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	queue := NewOfAny(testhelpers.NewMockStruct(10))
+
+	removed, err := queue.TryRemove(testhelpers.NewMockStruct(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	goassert.False(t, removed)
+	goassert.Equal(t, 1, queue.Size())
+}
+
+func Test_DequeueValueShouldRemoveAndReturnFrontElement_IfQueueIsNotEmpty(t *testing.T) {
+	queue := New(10, 16, 14)
+
+	element, ok := queue.DequeueValue()
+
+	goassert.True(t, ok)
+	goassert.Equal(t, 10, element)
+	verifyQueue(t, []int{16, 14}, &queue)
+}
+
+func Test_DequeueValueShouldReturnFalse_IfQueueIsEmpty(t *testing.T) {
+	queue := New[int]()
+
+	element, ok := queue.DequeueValue()
+
+	goassert.False(t, ok)
+	goassert.Equal(t, 0, element)
+}
+
+func Test_DequeueNShouldRemoveAndReturnFirstNElementsInOrder(t *testing.T) {
+	queue := New(10, 16, 14, 5)
+
+	elements := queue.DequeueN(3)
+
+	goassert.DeepEqual(t, []int{10, 16, 14}, elements)
+	verifyQueue(t, []int{5}, &queue)
+}
+
+func Test_DequeueNShouldReturnAllElements_GivenNGreaterThanSize(t *testing.T) {
+	queue := New(10, 16)
+
+	elements := queue.DequeueN(5)
+
+	goassert.DeepEqual(t, []int{10, 16}, elements)
+	goassert.True(t, queue.Empty())
+}
+
+func Test_DequeueNShouldReturnEmptySlice_GivenNonPositiveN(t *testing.T) {
+	queue := New(10, 16)
+
+	goassert.Equal(t, 0, len(queue.DequeueN(0)))
+	goassert.Equal(t, 0, len(queue.DequeueN(-1)))
+	verifyQueue(t, []int{10, 16}, &queue)
+}
+
+func Test_EqualsShouldReturnTrue_GivenQueueWithEqualElementsInSameOrder(t *testing.T) {
+	queue := New(10, 16, 14)
+	otherQueue := New(10, 16, 14)
+
+	goassert.True(t, queue.Equals(&otherQueue))
+}
+
+func Test_EqualsShouldReturnFalse_GivenQueueWithDifferentOrderOrSize(t *testing.T) {
+	queue := New(10, 16, 14)
+	reordered := New(14, 16, 10)
+	shorter := New(10, 16)
+
+	goassert.False(t, queue.Equals(&reordered))
+	goassert.False(t, queue.Equals(&shorter))
+}
+
+func Test_EqualsShouldCompareDequeueOrderInBothDirections_GivenPriorityQueue(t *testing.T) {
+	pq := priorityqueue.Heapify([]int{5, 1, 3, 2, 4}, func(a *int, b *int) bool { return *a < *b })
+	pq.SetEqualityComparer(comparer.DefaultEquals[int])
+	ascending := New(1, 2, 3, 4, 5)
+	descending := New(5, 4, 3, 2, 1)
+
+	goassert.True(t, ascending.Equals(&pq))
+	goassert.True(t, pq.Equals(&ascending))
+	goassert.False(t, descending.Equals(&pq))
+	goassert.False(t, pq.Equals(&descending))
+	goassert.Equal(t, ascending.HashCode(comparer.DefaultHash[int]), pq.HashCode(comparer.DefaultHash[int]))
+}
+
+func Test_HashCodeShouldBeEqual_GivenEqualQueues(t *testing.T) {
+	queue := New(10, 16, 14)
+	otherQueue := New(10, 16, 14)
+	reordered := New(14, 16, 10)
+
+	hash := comparer.DefaultHash[int]
+	goassert.Equal(t, queue.HashCode(hash), otherQueue.HashCode(hash))
+	goassert.NotEqual(t, queue.HashCode(hash), reordered.HashCode(hash))
+}
@@ -0,0 +1,554 @@
+package minmaxheap
+
+import (
+	"sort"
+
+	"math/bits"
+
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/queue"
+)
+
+/*
+Min-max heap, a double-ended priority queue. Elements on even levels of the binary tree are smaller than all
+their descendants and elements on odd levels are greater than all their descendants, so both the smallest and
+the greatest elements can be peeked in O(1) time and popped in O(log n) time. This makes it a good fit for
+bounded top-K buffers which evict their worst element.
+As a Queuer, the MinMaxHeap dequeues its smallest element first unless it is created with the MaxAtFront
+option.
+Implements Queuer and Collectioner
+"SetEqualityComparer" method is required for "Remove" and "Contains" methods to work properly
+MinMaxHeap is not thread safe
+*/
+type MinMaxHeap[T any] struct {
+	equals     func(*T, *T) bool
+	less       func(*T, *T) bool
+	container  []T
+	maxAtFront bool
+}
+
+/*
+Configures a MinMaxHeap created through New or Heapify
+*/
+type Option func(*options)
+
+type options struct {
+	maxAtFront bool
+}
+
+/*
+Makes the Queuer methods of the MinMaxHeap (Dequeue, Peek and their variants) operate on the greatest element
+instead of the smallest one
+*/
+func MaxAtFront() Option {
+	return func(o *options) {
+		o.maxAtFront = true
+	}
+}
+
+/*
+Initializes a new instance of empty MinMaxHeap and returns it. "less" function must be passed in order to
+compare elements. If the "less(e0, e1)" returns true, it means "e0" is smaller than "e1". Options such as
+MaxAtFront can be given to configure the MinMaxHeap
+*/
+func New[T any](less func(*T, *T) bool, opts ...Option) MinMaxHeap[T] {
+	return newHeap(less, []T{}, opts)
+}
+
+/*
+Makes a copy of the given slice and arranges it into a MinMaxHeap in O(n) time
+*/
+func Heapify[T any](elements []T, less func(*T, *T) bool, opts ...Option) MinMaxHeap[T] {
+	container := make([]T, len(elements))
+	copy(container, elements)
+
+	h := newHeap(less, container, opts)
+	h.heapify()
+
+	return h
+}
+
+func newHeap[T any](less func(*T, *T) bool, container []T, opts []Option) MinMaxHeap[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return MinMaxHeap[T]{
+		less:       less,
+		container:  container,
+		maxAtFront: o.maxAtFront,
+	}
+}
+
+/*
+Sets the equality comparer which is required for "Remove" and "Contains" methods.
+Implements Queuer.SetEqualityComparer
+*/
+func (h *MinMaxHeap[T]) SetEqualityComparer(equals func(*T, *T) bool) {
+	h.equals = equals
+}
+
+/*
+Returns the number of elements in the MinMaxHeap.
+Implements Queuer.Size and Collectioner.Size
+*/
+func (h *MinMaxHeap[T]) Size() int {
+	return len(h.container)
+}
+
+/*
+Returns true if the MinMaxHeap is empty. Otherwise, false.
+Implements Queuer.Empty and Collectioner.Empty
+*/
+func (h *MinMaxHeap[T]) Empty() bool {
+	return len(h.container) == 0
+}
+
+/*
+Pushes the given value into the MinMaxHeap. Time complexity is O(log n).
+Implements Queuer.Enqueue
+*/
+func (h *MinMaxHeap[T]) Enqueue(element T) {
+	h.container = append(h.container, element)
+	h.bubbleUp(len(h.container) - 1)
+}
+
+/*
+Returns a reference to the smallest element without removing it. Panics if the MinMaxHeap is empty
+*/
+func (h *MinMaxHeap[T]) PeekMin() *T {
+	element, err := h.TryPeekMin()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the smallest element without removing it. Returns an error wrapping errors.ErrEmpty if
+the MinMaxHeap is empty
+*/
+func (h *MinMaxHeap[T]) TryPeekMin() (*T, error) {
+	if h.Empty() {
+		return nil, errors.Newf(errors.ErrEmpty, "Cannot PeekMin. MinMaxHeap is empty")
+	}
+
+	return &h.container[0], nil
+}
+
+/*
+Returns a reference to the greatest element without removing it. Panics if the MinMaxHeap is empty
+*/
+func (h *MinMaxHeap[T]) PeekMax() *T {
+	element, err := h.TryPeekMax()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the greatest element without removing it. Returns an error wrapping errors.ErrEmpty if
+the MinMaxHeap is empty
+*/
+func (h *MinMaxHeap[T]) TryPeekMax() (*T, error) {
+	if h.Empty() {
+		return nil, errors.Newf(errors.ErrEmpty, "Cannot PeekMax. MinMaxHeap is empty")
+	}
+
+	return &h.container[h.maxIndex()], nil
+}
+
+/*
+Removes the smallest element and returns it. Panics if the MinMaxHeap is empty. Time complexity is O(log n)
+*/
+func (h *MinMaxHeap[T]) PopMin() T {
+	element, err := h.TryPopMin()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Removes the smallest element and returns it. Returns an error wrapping errors.ErrEmpty if the MinMaxHeap is
+empty
+*/
+func (h *MinMaxHeap[T]) TryPopMin() (T, error) {
+	if h.Empty() {
+		var zero T
+		return zero, errors.Newf(errors.ErrEmpty, "Cannot PopMin. MinMaxHeap is empty")
+	}
+
+	return h.removeAt(0), nil
+}
+
+/*
+Removes the greatest element and returns it. Panics if the MinMaxHeap is empty. Time complexity is O(log n)
+*/
+func (h *MinMaxHeap[T]) PopMax() T {
+	element, err := h.TryPopMax()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Removes the greatest element and returns it. Returns an error wrapping errors.ErrEmpty if the MinMaxHeap is
+empty
+*/
+func (h *MinMaxHeap[T]) TryPopMax() (T, error) {
+	if h.Empty() {
+		var zero T
+		return zero, errors.Newf(errors.ErrEmpty, "Cannot PopMax. MinMaxHeap is empty")
+	}
+
+	return h.removeAt(h.maxIndex()), nil
+}
+
+/*
+Removes the element at the front of the MinMaxHeap, which is the smallest element unless the MinMaxHeap was
+created with the MaxAtFront option. Panics if the MinMaxHeap is empty.
+Implements Queuer.Dequeue
+*/
+func (h *MinMaxHeap[T]) Dequeue() {
+	if _, err := h.TryDequeue(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the element at the front of the MinMaxHeap and returns it. Returns an error wrapping errors.ErrEmpty if
+the MinMaxHeap is empty.
+Implements Queuer.TryDequeue
+*/
+func (h *MinMaxHeap[T]) TryDequeue() (T, error) {
+	if h.Empty() {
+		var zero T
+		return zero, errors.Newf(errors.ErrEmpty, "Cannot Dequeue. MinMaxHeap is empty")
+	}
+
+	return h.removeAt(h.frontIndex()), nil
+}
+
+/*
+Removes the element at the front of the MinMaxHeap and returns it along with true. Returns the zero value and
+false if the MinMaxHeap is empty.
+Implements Queuer.DequeueValue
+*/
+func (h *MinMaxHeap[T]) DequeueValue() (T, bool) {
+	element, err := h.TryDequeue()
+
+	return element, err == nil
+}
+
+/*
+Removes up to n elements from the front of the MinMaxHeap and returns them in the order they were dequeued.
+Returns fewer than n elements if the MinMaxHeap runs out of elements, and an empty slice if n is not positive.
+Implements Queuer.DequeueN
+*/
+func (h *MinMaxHeap[T]) DequeueN(n int) []T {
+	return generic.TakeN(n, h.Size(), h.TryDequeue)
+}
+
+/*
+Returns a reference to the element at the front of the MinMaxHeap without removing it. Panics if the
+MinMaxHeap is empty.
+Implements Queuer.Peek
+*/
+func (h *MinMaxHeap[T]) Peek() *T {
+	element, err := h.TryPeek()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the element at the front of the MinMaxHeap without removing it. Returns an error
+wrapping errors.ErrEmpty if the MinMaxHeap is empty.
+Implements Queuer.TryPeek
+*/
+func (h *MinMaxHeap[T]) TryPeek() (*T, error) {
+	if h.Empty() {
+		return nil, errors.Newf(errors.ErrEmpty, "Cannot Peek. MinMaxHeap is empty")
+	}
+
+	return &h.container[h.frontIndex()], nil
+}
+
+/*
+Enqueues the given value into the MinMaxHeap. Always returns true.
+Implements Collectioner.Add
+*/
+func (h *MinMaxHeap[T]) Add(element T) bool {
+	h.Enqueue(element)
+	return true
+}
+
+/*
+Removes the first occurrence of the given value. Returns true if an element of the same value was found and
+removed. If not, returns false. Panics if the equality comparer was not set.
+Implements Collectioner.Remove
+*/
+func (h *MinMaxHeap[T]) Remove(element T) bool {
+	removed, err := h.TryRemove(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return removed
+}
+
+/*
+Removes the first occurrence of the given value. Returns true if an element of the same value was found and
+removed. If not, returns false. Returns an error wrapping errors.ErrNoEqualityComparer if the equality
+comparer was not set
+*/
+func (h *MinMaxHeap[T]) TryRemove(element T) (bool, error) {
+	i, err := h.indexOf(element, "Remove")
+	if err != nil || i == -1 {
+		return false, err
+	}
+
+	h.removeAt(i)
+	return true, nil
+}
+
+/*
+Returns true if an element with the same value as the given value exists. Panics if the equality comparer was
+not set.
+Implements Queuer.Contains and Collectioner.Contains
+*/
+func (h *MinMaxHeap[T]) Contains(element T) bool {
+	contains, err := h.TryContains(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return contains
+}
+
+/*
+Returns true if an element with the same value as the given value exists. Returns an error wrapping
+errors.ErrNoEqualityComparer if the equality comparer was not set.
+Implements Queuer.TryContains
+*/
+func (h *MinMaxHeap[T]) TryContains(element T) (bool, error) {
+	i, err := h.indexOf(element, "Contains")
+	return i != -1, err
+}
+
+func (h *MinMaxHeap[T]) indexOf(element T, method string) (int, error) {
+	if h.equals == nil {
+		return -1, errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot execute %s. Equality comparer was not set",
+			method,
+		)
+	}
+
+	for i := range h.container {
+		if h.equals(&h.container[i], &element) {
+			return i, nil
+		}
+	}
+
+	return -1, nil
+}
+
+/*
+Returns true if the given queue has the same size and would dequeue equal elements in the same order, like
+queue.Equal. The elements of the MinMaxHeap are compared in the order of ToSortedSlice, so the order they are
+stored in does not matter. Panics if the equality comparer was not set. Time complexity is O(n log n).
+Implements Queuer.Equals
+*/
+func (h *MinMaxHeap[T]) Equals(other queue.ReadOnlyQueuer[T]) bool {
+	if h.equals == nil {
+		panic(errors.Newf(errors.ErrNoEqualityComparer, "Cannot execute Equals. Equality comparer was not set"))
+	}
+
+	return queue.Equal[T](h, other, h.equals)
+}
+
+/*
+Returns a hash code which depends on the elements of the MinMaxHeap and the order they would be dequeued in,
+but not on the order they are stored in.
+Implements Queuer.HashCode
+*/
+func (h *MinMaxHeap[T]) HashCode(hash func(*T) uint64) uint64 {
+	return queue.HashCode[T](h, hash)
+}
+
+/*
+Returns a new slice with the elements of the MinMaxHeap in the order they would be dequeued in: ascending, or
+descending if the MinMaxHeap was created with the MaxAtFront option. The MinMaxHeap is left untouched. Time
+complexity is O(n log n) and O(n) extra memory is used
+*/
+func (h *MinMaxHeap[T]) ToSortedSlice() []T {
+	elements := make([]T, len(h.container))
+	copy(elements, h.container)
+
+	sort.Slice(elements, func(i int, j int) bool {
+		if h.maxAtFront {
+			return h.less(&elements[j], &elements[i])
+		}
+
+		return h.less(&elements[i], &elements[j])
+	})
+
+	return elements
+}
+
+/*
+Empties the MinMaxHeap.
+Implements Queuer.Clear and Collectioner.Clear
+*/
+func (h *MinMaxHeap[T]) Clear() {
+	h.container = h.container[:0]
+}
+
+/*
+Executes the given "do" function on each element in the MinMaxHeap. After the elements are updated, the
+internal array is heapified again in order to restore the appropriate order. Time complexity is O(n).
+Implements Queuer.ForEach and Collectioner.ForEach
+*/
+func (h *MinMaxHeap[T]) ForEach(do func(*T)) {
+	for i := range h.container {
+		do(&h.container[i])
+	}
+
+	h.heapify()
+}
+
+func (h *MinMaxHeap[T]) frontIndex() int {
+	if h.maxAtFront {
+		return h.maxIndex()
+	}
+
+	return 0
+}
+
+// the greatest element is the root if it is alone, or the greater of its children otherwise
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch len(h.container) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+
+	if h.less(&h.container[1], &h.container[2]) {
+		return 2
+	}
+
+	return 1
+}
+
+func (h *MinMaxHeap[T]) heapify() {
+	for i := len(h.container)/2 - 1; i >= 0; i-- {
+		h.trickleDown(i)
+	}
+}
+
+func (h *MinMaxHeap[T]) removeAt(i int) T {
+	element := h.container[i]
+	last := len(h.container) - 1
+	h.container[i] = h.container[last]
+
+	var zero T
+	h.container[last] = zero
+	h.container = h.container[:last]
+
+	if i < last {
+		// the element moved into the gap may violate the order of both its ancestors and its descendants
+		h.bubbleUp(i)
+		h.trickleDown(i)
+	}
+
+	return element
+}
+
+// the root is on level 0, which is a min level
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+// returns true if the element at "i" belongs above the element at "j" on a min level or on a max level
+func (h *MinMaxHeap[T]) before(i int, j int, onMinLevel bool) bool {
+	if onMinLevel {
+		return h.less(&h.container[i], &h.container[j])
+	}
+
+	return h.less(&h.container[j], &h.container[i])
+}
+
+func (h *MinMaxHeap[T]) swap(i int, j int) {
+	h.container[i], h.container[j] = h.container[j], h.container[i]
+}
+
+func (h *MinMaxHeap[T]) bubbleUp(i int) {
+	if i == 0 {
+		return
+	}
+
+	onMinLevel := isMinLevel(i)
+	parent := (i - 1) / 2
+	if h.before(parent, i, onMinLevel) {
+		// the element belongs to the levels of the other kind, which start at its parent
+		h.swap(i, parent)
+		h.bubbleUpGrandparents(parent, !onMinLevel)
+	} else {
+		h.bubbleUpGrandparents(i, onMinLevel)
+	}
+}
+
+func (h *MinMaxHeap[T]) bubbleUpGrandparents(i int, onMinLevel bool) {
+	for i > 2 {
+		grandparent := ((i-1)/2 - 1) / 2
+		if !h.before(i, grandparent, onMinLevel) {
+			return
+		}
+
+		h.swap(i, grandparent)
+		i = grandparent
+	}
+}
+
+func (h *MinMaxHeap[T]) trickleDown(i int) {
+	onMinLevel := isMinLevel(i)
+	size := len(h.container)
+
+	for {
+		// the first (smallest or greatest) of the children and grandchildren of "i"
+		first := -1
+		for _, descendant := range []int{2*i + 1, 2*i + 2, 4*i + 3, 4*i + 4, 4*i + 5, 4*i + 6} {
+			if descendant < size && (first == -1 || h.before(descendant, first, onMinLevel)) {
+				first = descendant
+			}
+		}
+		if first == -1 || !h.before(first, i, onMinLevel) {
+			return
+		}
+
+		h.swap(first, i)
+		if first <= 2*i+2 {
+			return
+		}
+
+		// the element moved down to a grandchild may now belong above the parent of that grandchild
+		parent := (first - 1) / 2
+		if h.before(parent, first, onMinLevel) {
+			h.swap(first, parent)
+		}
+		i = first
+	}
+}
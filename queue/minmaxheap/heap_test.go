@@ -0,0 +1,259 @@
+package minmaxheap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/queue"
+	"github.com/golanglibs/gocollections/queue/priorityqueue"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testQueuer[T any](q queue.Queuer[T]) {}
+
+func testCollectioner[T any](c generic.Collectioner[T]) {}
+
+func less(a *int, b *int) bool {
+	return *a < *b
+}
+
+// verifies that every element on a min level is smaller than or equal to all its descendants and every
+// element on a max level is greater than or equal to all its descendants
+func verifyHeap(t *testing.T, h *MinMaxHeap[int]) {
+	t.Helper()
+
+	for i := range h.container {
+		for ancestor := (i - 1) / 2; i > 0; ancestor = (ancestor - 1) / 2 {
+			if isMinLevel(ancestor) {
+				goassert.False(t, h.less(&h.container[i], &h.container[ancestor]))
+			} else {
+				goassert.False(t, h.less(&h.container[ancestor], &h.container[i]))
+			}
+			if ancestor == 0 {
+				break
+			}
+		}
+	}
+}
+
+func Test_MinMaxHeapShouldImplementQueuerAndCollectioner(t *testing.T) {
+	h := New(less)
+
+	testQueuer[int](&h)
+	testCollectioner[int](&h)
+}
+
+func Test_NewShouldCreateEmptyMinMaxHeap(t *testing.T) {
+	h := New(less)
+
+	goassert.Equal(t, 0, h.Size())
+	goassert.True(t, h.Empty())
+}
+
+func Test_MinMaxHeapShouldPeekAndPopBothEnds(t *testing.T) {
+	h := New(less)
+	for _, element := range []int{14, 16, 5, 23, 7, 10, 1, 30} {
+		h.Enqueue(element)
+	}
+	verifyHeap(t, &h)
+
+	goassert.Equal(t, 1, *h.PeekMin())
+	goassert.Equal(t, 30, *h.PeekMax())
+	goassert.Equal(t, 1, h.PopMin())
+	goassert.Equal(t, 30, h.PopMax())
+	goassert.Equal(t, 23, h.PopMax())
+	goassert.Equal(t, 5, h.PopMin())
+	goassert.Equal(t, 4, h.Size())
+	verifyHeap(t, &h)
+}
+
+func Test_MinMaxHeapShouldPeekAndPopMax_GivenOneOrTwoElements(t *testing.T) {
+	h := New(less)
+	h.Enqueue(5)
+
+	goassert.Equal(t, 5, *h.PeekMax())
+
+	h.Enqueue(3)
+
+	goassert.Equal(t, 5, *h.PeekMax())
+	goassert.Equal(t, 3, *h.PeekMin())
+	goassert.Equal(t, 5, h.PopMax())
+	goassert.Equal(t, 3, h.PopMax())
+	goassert.True(t, h.Empty())
+}
+
+func Test_HeapifyShouldArrangeCopyOfGivenSlice(t *testing.T) {
+	elements := []int{14, 16, 5, 23, 7, 10, 1, 30, 2, 8, 19}
+
+	h := Heapify(elements, less)
+	elements[0] = 100
+	verifyHeap(t, &h)
+
+	goassert.Equal(t, 1, *h.PeekMin())
+	goassert.Equal(t, 30, *h.PeekMax())
+	goassert.DeepEqual(t, []int{1, 2, 5, 7, 8, 10, 14, 16, 19, 23, 30}, h.DequeueN(20))
+}
+
+func Test_MaxAtFrontShouldMakeQueuerMethodsOperateOnGreatestElement(t *testing.T) {
+	h := Heapify([]int{14, 16, 5, 23, 7}, less, MaxAtFront())
+
+	goassert.Equal(t, 23, *h.Peek())
+	goassert.DeepEqual(t, []int{23, 16, 14}, h.DequeueN(3))
+
+	value, found := h.DequeueValue()
+	goassert.Equal(t, 7, value)
+	goassert.True(t, found)
+}
+
+func Test_MinMaxHeapShouldPanic_GivenMinMaxHeapIsEmpty(t *testing.T) {
+	h := New(less)
+
+	_, peekMinErr := h.TryPeekMin()
+	_, peekMaxErr := h.TryPeekMax()
+	_, popMinErr := h.TryPopMin()
+	_, popMaxErr := h.TryPopMax()
+	_, dequeueErr := h.TryDequeue()
+	_, peekErr := h.TryPeek()
+	_, found := h.DequeueValue()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, peekMinErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, peekMaxErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, popMinErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, popMaxErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, dequeueErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, peekErr)
+	goassert.False(t, found)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot PopMax. MinMaxHeap is empty", func() {
+		h.PopMax()
+	})
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot Dequeue. MinMaxHeap is empty", func() {
+		h.Dequeue()
+	})
+}
+
+func Test_RemoveAndContains(t *testing.T) {
+	h := Heapify([]int{14, 16, 5, 23, 7, 10, 1, 30, 2, 8, 19}, less)
+	h.SetEqualityComparer(comparer.DefaultEquals[int])
+
+	goassert.True(t, h.Remove(16))
+	goassert.True(t, h.Remove(1))
+	goassert.False(t, h.Remove(16))
+	goassert.False(t, h.Contains(16))
+	goassert.True(t, h.Contains(30))
+	verifyHeap(t, &h)
+}
+
+func Test_RemoveShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
+	h := Heapify([]int{14, 16}, less)
+
+	_, err := h.TryContains(14)
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot execute Remove. Equality comparer was not set",
+		func() {
+			h.Remove(14)
+		},
+	)
+}
+
+func Test_EqualsAndHashCodeShouldIgnoreStorageOrder(t *testing.T) {
+	h := Heapify([]int{14, 16, 5}, less)
+	h.SetEqualityComparer(comparer.DefaultEquals[int])
+	pq := priorityqueue.Heapify([]int{5, 16, 14}, less)
+	pq.SetEqualityComparer(comparer.DefaultEquals[int])
+
+	goassert.True(t, h.Equals(&pq))
+	goassert.True(t, pq.Equals(&h))
+	goassert.Equal(t, pq.HashCode(comparer.DefaultHash[int]), h.HashCode(comparer.DefaultHash[int]))
+}
+
+func Test_EqualsShouldCompareDequeueOrder_GivenMaxAtFront(t *testing.T) {
+	h := Heapify([]int{14, 16, 5}, less, MaxAtFront())
+	h.SetEqualityComparer(comparer.DefaultEquals[int])
+	pq := priorityqueue.Heapify([]int{5, 16, 14}, less)
+	pq.SetEqualityComparer(comparer.DefaultEquals[int])
+
+	goassert.False(t, h.Equals(&pq))
+	goassert.False(t, pq.Equals(&h))
+}
+
+func Test_ToSortedSliceShouldReturnElementsInDequeueOrder_WithoutModifyingMinMaxHeap(t *testing.T) {
+	h := Heapify([]int{14, 16, 5, 23, 7}, less)
+	maxAtFront := Heapify([]int{14, 16, 5, 23, 7}, less, MaxAtFront())
+
+	goassert.DeepEqual(t, []int{5, 7, 14, 16, 23}, h.ToSortedSlice())
+	goassert.DeepEqual(t, []int{23, 16, 14, 7, 5}, maxAtFront.ToSortedSlice())
+	goassert.Equal(t, 5, h.Size())
+	verifyHeap(t, &h)
+}
+
+func Test_ForEachShouldRestoreOrder_AfterElementsAreUpdated(t *testing.T) {
+	h := Heapify([]int{14, 16, 5, 23, 7}, less)
+
+	h.ForEach(func(element *int) {
+		*element = 100 - *element
+	})
+	verifyHeap(t, &h)
+
+	goassert.Equal(t, 77, *h.PeekMin())
+	goassert.Equal(t, 95, *h.PeekMax())
+}
+
+func Test_ClearShouldEmptyMinMaxHeap(t *testing.T) {
+	h := Heapify([]int{14, 16, 5}, less)
+
+	h.Clear()
+	h.Enqueue(1)
+
+	goassert.Equal(t, 1, h.Size())
+	goassert.Equal(t, 1, *h.PeekMax())
+}
+
+func Test_MinMaxHeapShouldMatchSortedModel_GivenRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(11))
+	h := New(less)
+	h.SetEqualityComparer(comparer.DefaultEquals[int])
+	model := make([]int, 0)
+
+	for i := 0; i < 5000; i++ {
+		switch random.Intn(5) {
+		case 0:
+			if len(model) > 0 {
+				goassert.Equal(t, model[0], h.PopMin())
+				model = model[1:]
+			}
+		case 1:
+			if len(model) > 0 {
+				goassert.Equal(t, model[len(model)-1], h.PopMax())
+				model = model[:len(model)-1]
+			}
+		case 2:
+			if len(model) > 0 {
+				j := random.Intn(len(model))
+				goassert.True(t, h.Remove(model[j]))
+				model = append(model[:j], model[j+1:]...)
+				verifyHeap(t, &h)
+			}
+		default:
+			element := random.Intn(1000)
+			h.Enqueue(element)
+			model = append(model, element)
+			sort.Ints(model)
+		}
+
+		goassert.Equal(t, len(model), h.Size())
+		if len(model) > 0 {
+			goassert.Equal(t, model[0], *h.PeekMin())
+			goassert.Equal(t, model[len(model)-1], *h.PeekMax())
+		}
+	}
+	verifyHeap(t, &h)
+}
@@ -0,0 +1,47 @@
+package queue
+
+import "github.com/golanglibs/gocollections/generic"
+
+/*
+Returns the elements of the given queue in the order they would be dequeued, without modifying the queue. Heaps
+do not visit their elements in dequeue order through ForEach, so they provide it through a ToSortedSlice method,
+which is used when present. The ForEach method of any other queue is expected to visit the elements from the
+front to the back
+*/
+func DequeueOrder[T any](q ReadOnlyQueuer[T]) generic.Traversable[T] {
+	if sorted, ok := q.(interface{ ToSortedSlice() []T }); ok {
+		return elements[T](sorted.ToSortedSlice())
+	}
+
+	return q
+}
+
+/*
+Returns true if the given queues have the same size and would dequeue equal elements in the same order. Elements
+are compared with the given equals function. Every implementation of ReadOnlyQueuer.Equals follows this
+definition, so it does not matter which of the two queues Equals is called on
+*/
+func Equal[T any](a ReadOnlyQueuer[T], b ReadOnlyQueuer[T], equals func(*T, *T) bool) bool {
+	return generic.SequenceEqual[T](DequeueOrder[T](a), DequeueOrder[T](b), equals)
+}
+
+/*
+Returns a hash code of the given queue which depends on its elements and the order they would be dequeued in.
+Elements are hashed with the given hash function. Queues which are Equal have equal hash codes when the hash
+function is consistent with the equality comparer
+*/
+func HashCode[T any](q ReadOnlyQueuer[T], hash func(*T) uint64) uint64 {
+	return generic.OrderedHashCode[T](DequeueOrder[T](q), hash)
+}
+
+type elements[T any] []T
+
+func (e elements[T]) Size() int {
+	return len(e)
+}
+
+func (e elements[T]) ForEach(do func(*T)) {
+	for i := range e {
+		do(&e[i])
+	}
+}
@@ -0,0 +1,527 @@
+package pairingheap
+
+import (
+	"sort"
+
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/queue"
+)
+
+/*
+Pairing heap, a pointer based mergeable heap. Enqueue and Meld take O(1) time, Dequeue takes amortized
+O(log n) time, and the priority of an element can be raised through its Node in O(1) time (the node is cut
+from its parent and linked with the root; the amortized bound is o(log n) and behaves like O(1) in practice).
+Implements Queuer and Collectioner
+"SetEqualityComparer" method is required for "Remove" and "Contains" methods to work properly
+PairingHeap is not thread safe
+*/
+type PairingHeap[T any] struct {
+	equals  func(*T, *T) bool
+	compare func(*T, *T) bool
+	root    *Node[T]
+	size    int
+	owner   *owner
+	// reused to collect the children of removed nodes
+	scratch []*Node[T]
+}
+
+/*
+Handle of an element stored in a PairingHeap, returned by Push. It stays valid until the element is removed,
+and moves along with the element when its heap is melded into another one
+*/
+type Node[T any] struct {
+	value T
+	// leftmost child
+	child *Node[T]
+	// next sibling
+	next *Node[T]
+	// previous sibling, or parent if the node is the leftmost child
+	prev  *Node[T]
+	owner *owner
+}
+
+/*
+Identifies the heap a Node belongs to. Melding a heap into another one forwards the owner of the melded heap to
+the owner of the other heap instead of visiting every node, like the union of a disjoint-set forest
+*/
+type owner struct {
+	forward *owner
+}
+
+func (o *owner) resolve() *owner {
+	root := o
+	for root.forward != nil {
+		root = root.forward
+	}
+	for o != root {
+		o, o.forward = o.forward, root
+	}
+
+	return root
+}
+
+/*
+Returns a copy of the value of the element
+*/
+func (n *Node[T]) Value() T {
+	return n.value
+}
+
+/*
+Initializes a new instance of empty PairingHeap and returns it. "compare" function must be passed in order to
+compare elements. If the "compare(e0, e1)" returns true, it means "e0" will have higher priority than "e1" and
+will appear earlier than "e1" when dequeueing
+*/
+func New[T any](compare func(*T, *T) bool) PairingHeap[T] {
+	return PairingHeap[T]{
+		compare: compare,
+		owner:   &owner{},
+	}
+}
+
+/*
+Sets the equality comparer which is required for "Remove" and "Contains" methods.
+Implements Queuer.SetEqualityComparer
+*/
+func (h *PairingHeap[T]) SetEqualityComparer(equals func(*T, *T) bool) {
+	h.equals = equals
+}
+
+/*
+Returns the number of elements in the PairingHeap.
+Implements Queuer.Size and Collectioner.Size
+*/
+func (h *PairingHeap[T]) Size() int {
+	return h.size
+}
+
+/*
+Returns true if the PairingHeap is empty. Otherwise, false.
+Implements Queuer.Empty and Collectioner.Empty
+*/
+func (h *PairingHeap[T]) Empty() bool {
+	return h.size == 0
+}
+
+/*
+Pushes the given value into the PairingHeap in O(1) time and returns its Node, which can be used to update or
+remove the element later
+*/
+func (h *PairingHeap[T]) Push(element T) *Node[T] {
+	n := &Node[T]{value: element, owner: h.owner}
+	h.root = h.link(h.root, n)
+	h.size++
+
+	return n
+}
+
+/*
+Pushes the given value into the PairingHeap in O(1) time.
+Implements Queuer.Enqueue
+*/
+func (h *PairingHeap[T]) Enqueue(element T) {
+	h.Push(element)
+}
+
+/*
+Moves all the elements of the given PairingHeap into the PairingHeap in O(1) time, leaving the given
+PairingHeap empty. The Nodes of the moved elements stay valid and now belong to the PairingHeap. Both heaps
+must order elements the same way
+*/
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == h || other.root == nil {
+		return
+	}
+
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+	other.owner.forward = h.owner
+
+	other.root = nil
+	other.size = 0
+	other.owner = &owner{}
+}
+
+/*
+Removes the top element (with the highest priority) in the PairingHeap. Panics if the PairingHeap is empty.
+Implements Queuer.Dequeue
+*/
+func (h *PairingHeap[T]) Dequeue() {
+	if _, err := h.TryDequeue(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the top element (with the highest priority) in the PairingHeap and returns it. Returns an error
+wrapping errors.ErrEmpty if the PairingHeap is empty.
+Implements Queuer.TryDequeue
+*/
+func (h *PairingHeap[T]) TryDequeue() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, errors.Newf(errors.ErrEmpty, "Cannot Dequeue. PairingHeap is empty")
+	}
+
+	top := h.root
+	h.removeNode(top)
+
+	return top.value, nil
+}
+
+/*
+Removes the top element (with the highest priority) in the PairingHeap and returns it along with true. Returns
+the zero value and false if the PairingHeap is empty.
+Implements Queuer.DequeueValue
+*/
+func (h *PairingHeap[T]) DequeueValue() (T, bool) {
+	element, err := h.TryDequeue()
+
+	return element, err == nil
+}
+
+/*
+Removes up to n elements with the highest priorities and returns them in priority order. Returns fewer than n
+elements if the PairingHeap runs out of elements, and an empty slice if n is not positive.
+Implements Queuer.DequeueN
+*/
+func (h *PairingHeap[T]) DequeueN(n int) []T {
+	return generic.TakeN(n, h.Size(), h.TryDequeue)
+}
+
+/*
+Returns a reference to the top element (with the highest priority) without removing it. Panics if the
+PairingHeap is empty.
+Implements Queuer.Peek
+*/
+func (h *PairingHeap[T]) Peek() *T {
+	element, err := h.TryPeek()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the top element (with the highest priority) without removing it. Returns an error
+wrapping errors.ErrEmpty if the PairingHeap is empty.
+Implements Queuer.TryPeek
+*/
+func (h *PairingHeap[T]) TryPeek() (*T, error) {
+	if h.root == nil {
+		return nil, errors.Newf(errors.ErrEmpty, "Cannot Peek. PairingHeap is empty")
+	}
+
+	return &h.root.value, nil
+}
+
+/*
+Replaces the value of the element of the given Node. Raising the priority of the element takes O(1) time,
+while lowering it takes amortized O(log n) time. Panics with an error wrapping errors.ErrKeyNotFound if the
+Node was removed or belongs to another heap
+*/
+func (h *PairingHeap[T]) Update(n *Node[T], value T) {
+	if err := h.TryUpdate(n, value); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Replaces the value of the element of the given Node. Returns an error wrapping errors.ErrKeyNotFound if the
+Node was removed or belongs to another heap
+*/
+func (h *PairingHeap[T]) TryUpdate(n *Node[T], value T) error {
+	if !h.owns(n) {
+		return errors.Newf(errors.ErrKeyNotFound, "Cannot Update. Node does not belong to the PairingHeap")
+	}
+
+	lowered := h.compare(&n.value, &value)
+	n.value = value
+	if n == h.root && !lowered {
+		return nil
+	}
+
+	if lowered {
+		// the children of the node may now have higher priorities than the node itself
+		h.removeNode(n)
+		n.owner = h.owner
+		h.root = h.link(h.root, n)
+		h.size++
+		return nil
+	}
+
+	h.cut(n)
+	h.root = h.link(h.root, n)
+
+	return nil
+}
+
+/*
+Removes the element of the given Node in amortized O(log n) time. Returns false if the Node was already
+removed or belongs to another heap
+*/
+func (h *PairingHeap[T]) RemoveNode(n *Node[T]) bool {
+	if !h.owns(n) {
+		return false
+	}
+
+	h.removeNode(n)
+	return true
+}
+
+/*
+Enqueues the given value into the PairingHeap. Always returns true.
+Implements Collectioner.Add
+*/
+func (h *PairingHeap[T]) Add(element T) bool {
+	h.Push(element)
+	return true
+}
+
+/*
+Removes the first occurrence of the given value found. Returns true if an element of the same value was found
+and removed. If not, returns false. Panics if the equality comparer was not set.
+Implements Collectioner.Remove
+*/
+func (h *PairingHeap[T]) Remove(element T) bool {
+	removed, err := h.TryRemove(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return removed
+}
+
+/*
+Removes the first occurrence of the given value found. Returns true if an element of the same value was found
+and removed. If not, returns false. Returns an error wrapping errors.ErrNoEqualityComparer if the equality
+comparer was not set
+*/
+func (h *PairingHeap[T]) TryRemove(element T) (bool, error) {
+	n, err := h.find(element, "Remove")
+	if err != nil || n == nil {
+		return false, err
+	}
+
+	h.removeNode(n)
+	return true, nil
+}
+
+/*
+Returns true if an element with the same value as the given value exists. Panics if the equality comparer was
+not set.
+Implements Queuer.Contains and Collectioner.Contains
+*/
+func (h *PairingHeap[T]) Contains(element T) bool {
+	contains, err := h.TryContains(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return contains
+}
+
+/*
+Returns true if an element with the same value as the given value exists. Returns an error wrapping
+errors.ErrNoEqualityComparer if the equality comparer was not set.
+Implements Queuer.TryContains
+*/
+func (h *PairingHeap[T]) TryContains(element T) (bool, error) {
+	n, err := h.find(element, "Contains")
+	return n != nil, err
+}
+
+/*
+Returns true if the given queue has the same size and would dequeue equal elements in the same order, like
+queue.Equal. The elements of the PairingHeap are compared in the order of ToSortedSlice, so the shape of the
+heap does not matter. Panics if the equality comparer was not set. Time complexity is O(n log n).
+Implements Queuer.Equals
+*/
+func (h *PairingHeap[T]) Equals(other queue.ReadOnlyQueuer[T]) bool {
+	if h.equals == nil {
+		panic(errors.Newf(errors.ErrNoEqualityComparer, "Cannot execute Equals. Equality comparer was not set"))
+	}
+
+	return queue.Equal[T](h, other, h.equals)
+}
+
+/*
+Returns a hash code which depends on the elements of the PairingHeap and the order they would be dequeued in,
+but not on the shape of the heap.
+Implements Queuer.HashCode
+*/
+func (h *PairingHeap[T]) HashCode(hash func(*T) uint64) uint64 {
+	return queue.HashCode[T](h, hash)
+}
+
+/*
+Returns a new slice with the elements of the PairingHeap in priority order, which is the order they would be
+dequeued in. The PairingHeap is left untouched. Time complexity is O(n log n) and O(n) extra memory is used
+*/
+func (h *PairingHeap[T]) ToSortedSlice() []T {
+	elements := make([]T, 0, h.size)
+	for _, n := range h.nodes() {
+		elements = append(elements, n.value)
+	}
+
+	sort.Slice(elements, func(i int, j int) bool {
+		return h.compare(&elements[i], &elements[j])
+	})
+
+	return elements
+}
+
+/*
+Empties the PairingHeap. The Nodes of the removed elements become invalid.
+Implements Queuer.Clear and Collectioner.Clear
+*/
+func (h *PairingHeap[T]) Clear() {
+	h.root = nil
+	h.size = 0
+	h.owner = &owner{}
+}
+
+/*
+Executes the given "do" function on each element in the PairingHeap. After the elements are updated, the heap
+is rebuilt in order to restore the appropriate order. Time complexity is O(n).
+Implements Queuer.ForEach and Collectioner.ForEach
+*/
+func (h *PairingHeap[T]) ForEach(do func(*T)) {
+	nodes := h.nodes()
+	for _, n := range nodes {
+		do(&n.value)
+		n.child, n.next, n.prev = nil, nil, nil
+	}
+
+	h.root = h.combine(nodes)
+}
+
+func (h *PairingHeap[T]) owns(n *Node[T]) bool {
+	return n != nil && n.owner != nil && n.owner.resolve() == h.owner
+}
+
+func (h *PairingHeap[T]) find(element T, method string) (*Node[T], error) {
+	if h.equals == nil {
+		return nil, errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot execute %s. Equality comparer was not set",
+			method,
+		)
+	}
+
+	for _, n := range h.nodes() {
+		if h.equals(&n.value, &element) {
+			return n, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (h *PairingHeap[T]) nodes() []*Node[T] {
+	nodes := make([]*Node[T], 0, h.size)
+	if h.root == nil {
+		return nodes
+	}
+
+	nodes = append(nodes, h.root)
+	for i := 0; i < len(nodes); i++ {
+		for child := nodes[i].child; child != nil; child = child.next {
+			nodes = append(nodes, child)
+		}
+	}
+
+	return nodes
+}
+
+/*
+Makes the root with the lower priority the leftmost child of the other root and returns the new root. Either
+root may be nil
+*/
+func (h *PairingHeap[T]) link(a *Node[T], b *Node[T]) *Node[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.compare(&b.value, &a.value) {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	a.next, a.prev = nil, nil
+
+	return a
+}
+
+/*
+Detaches the given node, along with its children, from its parent and siblings. The node must not be the root
+*/
+func (h *PairingHeap[T]) cut(n *Node[T]) {
+	if n.prev.child == n {
+		n.prev.child = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	}
+
+	n.next, n.prev = nil, nil
+}
+
+func (h *PairingHeap[T]) removeNode(n *Node[T]) {
+	children := h.scratch[:0]
+	for child := n.child; child != nil; child = child.next {
+		children = append(children, child)
+	}
+	for _, child := range children {
+		child.next, child.prev = nil, nil
+	}
+
+	if n == h.root {
+		h.root = h.combine(children)
+	} else {
+		h.cut(n)
+		h.root = h.link(h.root, h.combine(children))
+	}
+
+	for i := range children {
+		children[i] = nil
+	}
+	h.scratch = children
+
+	n.child, n.owner = nil, nil
+	h.size--
+}
+
+/*
+Melds the given detached roots with the two-pass pairing: the roots are linked in pairs from left to right,
+then the pairs are linked from right to left
+*/
+func (h *PairingHeap[T]) combine(roots []*Node[T]) *Node[T] {
+	pairs := 0
+	for i := 0; i < len(roots); i += 2 {
+		if i+1 < len(roots) {
+			roots[pairs] = h.link(roots[i], roots[i+1])
+		} else {
+			roots[pairs] = roots[i]
+		}
+		pairs++
+	}
+
+	var root *Node[T]
+	for i := pairs - 1; i >= 0; i-- {
+		root = h.link(roots[i], root)
+	}
+
+	return root
+}
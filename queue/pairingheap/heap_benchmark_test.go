@@ -0,0 +1,172 @@
+package pairingheap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golanglibs/gocollections/queue/indexedpriorityqueue"
+	"github.com/golanglibs/gocollections/queue/priorityqueue"
+)
+
+type edge struct {
+	to     int
+	weight int
+}
+
+type distance struct {
+	vertex   int
+	distance int
+}
+
+func lessDistance(a *distance, b *distance) bool {
+	return a.distance < b.distance
+}
+
+func lessInt(a *int, b *int) bool {
+	return *a < *b
+}
+
+func randomGraph(vertices int, edgesPerVertex int) [][]edge {
+	random := rand.New(rand.NewSource(1))
+	graph := make([][]edge, vertices)
+	for from := range graph {
+		for i := 0; i < edgesPerVertex; i++ {
+			graph[from] = append(graph[from], edge{to: random.Intn(vertices), weight: random.Intn(1000) + 1})
+		}
+	}
+
+	return graph
+}
+
+var benchmarkGraph = randomGraph(10000, 8)
+
+const unreachable = int(^uint(0) >> 1)
+
+func newDistances(vertices int) []int {
+	distances := make([]int, vertices)
+	for i := range distances {
+		distances[i] = unreachable
+	}
+	distances[0] = 0
+
+	return distances
+}
+
+// binary heap without decrease-key: improved distances are enqueued again and stale entries are skipped
+func dijkstraPriorityQueue(graph [][]edge) []int {
+	distances := newDistances(len(graph))
+	pq := priorityqueue.New(lessDistance)
+	pq.Enqueue(distance{vertex: 0})
+
+	for !pq.Empty() {
+		current, _ := pq.DequeueValue()
+		if current.distance > distances[current.vertex] {
+			continue
+		}
+		for _, e := range graph[current.vertex] {
+			if d := current.distance + e.weight; d < distances[e.to] {
+				distances[e.to] = d
+				pq.Enqueue(distance{vertex: e.to, distance: d})
+			}
+		}
+	}
+
+	return distances
+}
+
+func dijkstraIndexedPriorityQueue(graph [][]edge) []int {
+	distances := newDistances(len(graph))
+	pq := indexedpriorityqueue.New[int](lessInt)
+	pq.Enqueue(0, 0)
+
+	for !pq.Empty() {
+		vertex, d, _ := pq.TryDequeue()
+		for _, e := range graph[vertex] {
+			if next := d + e.weight; next < distances[e.to] {
+				distances[e.to] = next
+				pq.Enqueue(e.to, next)
+			}
+		}
+	}
+
+	return distances
+}
+
+func dijkstraPairingHeap(graph [][]edge) []int {
+	distances := newDistances(len(graph))
+	nodes := make([]*Node[distance], len(graph))
+	h := New(lessDistance)
+	nodes[0] = h.Push(distance{vertex: 0})
+
+	for !h.Empty() {
+		current, _ := h.DequeueValue()
+		nodes[current.vertex] = nil
+		for _, e := range graph[current.vertex] {
+			d := current.distance + e.weight
+			if d >= distances[e.to] {
+				continue
+			}
+			distances[e.to] = d
+			if nodes[e.to] == nil {
+				nodes[e.to] = h.Push(distance{vertex: e.to, distance: d})
+			} else {
+				h.Update(nodes[e.to], distance{vertex: e.to, distance: d})
+			}
+		}
+	}
+
+	return distances
+}
+
+func Test_DijkstraBenchmarkImplementationsShouldAgree(t *testing.T) {
+	graph := randomGraph(500, 4)
+	expected := dijkstraPriorityQueue(graph)
+
+	for _, actual := range [][]int{dijkstraIndexedPriorityQueue(graph), dijkstraPairingHeap(graph)} {
+		for i := range expected {
+			if expected[i] != actual[i] {
+				t.Fatalf("Expected distance %d to vertex %d but got %d", expected[i], i, actual[i])
+			}
+		}
+	}
+}
+
+func Benchmark_DijkstraPriorityQueue(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		dijkstraPriorityQueue(benchmarkGraph)
+	}
+}
+
+func Benchmark_DijkstraIndexedPriorityQueue(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		dijkstraIndexedPriorityQueue(benchmarkGraph)
+	}
+}
+
+func Benchmark_DijkstraPairingHeap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		dijkstraPairingHeap(benchmarkGraph)
+	}
+}
+
+func Benchmark_PairingHeapEnqueueDequeue(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	h := New(lessInt)
+	for i := 0; i < b.N; i++ {
+		h.Enqueue(random.Int())
+		if h.Size() > 1000 {
+			h.Dequeue()
+		}
+	}
+}
+
+func Benchmark_PriorityQueueEnqueueDequeue(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	pq := priorityqueue.New(lessInt)
+	for i := 0; i < b.N; i++ {
+		pq.Enqueue(random.Int())
+		if pq.Size() > 1000 {
+			pq.Dequeue()
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package pairingheap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/queue"
+	"github.com/golanglibs/gocollections/queue/priorityqueue"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testQueuer[T any](q queue.Queuer[T]) {}
+
+func testCollectioner[T any](c generic.Collectioner[T]) {}
+
+func less(a *int, b *int) bool {
+	return *a < *b
+}
+
+func Test_PairingHeapShouldImplementQueuerAndCollectioner(t *testing.T) {
+	h := New(less)
+
+	testQueuer[int](&h)
+	testCollectioner[int](&h)
+}
+
+func Test_NewShouldCreateEmptyPairingHeap(t *testing.T) {
+	h := New(less)
+
+	goassert.Equal(t, 0, h.Size())
+	goassert.True(t, h.Empty())
+}
+
+func Test_PairingHeapShouldDequeueInPriorityOrder(t *testing.T) {
+	h := New(less)
+	for _, element := range []int{14, 16, 5, 23, 7, 10, 1, 30} {
+		h.Enqueue(element)
+	}
+
+	goassert.Equal(t, 1, *h.Peek())
+	goassert.Equal(t, 8, h.Size())
+	goassert.DeepEqual(t, []int{1, 5, 7, 10, 14, 16, 23, 30}, h.DequeueN(10))
+	goassert.True(t, h.Empty())
+}
+
+func Test_MeldShouldMoveAllElements_AndKeepNodesValid(t *testing.T) {
+	a := New(less)
+	b := New(less)
+	a.Push(5)
+	a.Push(1)
+	node := b.Push(7)
+	b.Push(3)
+
+	a.Meld(&b)
+
+	goassert.Equal(t, 4, a.Size())
+	goassert.True(t, b.Empty())
+	goassert.False(t, b.RemoveNode(node))
+
+	a.Update(node, 0)
+
+	goassert.DeepEqual(t, []int{0, 1, 3, 5}, a.DequeueN(4))
+}
+
+func Test_MeldShouldKeepNodesValid_AcrossSeveralMelds(t *testing.T) {
+	a, b, c := New(less), New(less), New(less)
+	node := c.Push(10)
+	b.Push(20)
+	a.Push(30)
+
+	b.Meld(&c)
+	a.Meld(&b)
+	c.Push(5)
+
+	goassert.True(t, a.RemoveNode(node))
+	goassert.False(t, c.RemoveNode(node))
+	goassert.DeepEqual(t, []int{20, 30}, a.DequeueN(2))
+	goassert.Equal(t, 5, *c.Peek())
+}
+
+func Test_UpdateShouldMoveElement_GivenPriorityIsRaisedOrLowered(t *testing.T) {
+	h := New(less)
+	nodes := make([]*Node[int], 0)
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, h.Push(i*10))
+	}
+	h.Dequeue()
+	h.Push(-5)
+
+	h.Update(nodes[7], -10)
+	h.Update(nodes[1], 95)
+	h.Update(nodes[4], 45)
+
+	goassert.Equal(t, -10, nodes[7].Value())
+	goassert.DeepEqual(t, []int{-10, -5, 20, 30, 45, 50, 60, 80, 90, 95}, h.DequeueN(10))
+}
+
+func Test_UpdateShouldPanic_GivenNodeWasRemoved(t *testing.T) {
+	h := New(less)
+	node := h.Push(1)
+	h.Dequeue()
+
+	err := h.TryUpdate(node, 2)
+
+	testhelpers.ErrorIs(t, errors.ErrKeyNotFound, err)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrKeyNotFound,
+		"Cannot Update. Node does not belong to the PairingHeap",
+		func() {
+			h.Update(node, 2)
+		},
+	)
+}
+
+func Test_RemoveNode(t *testing.T) {
+	h := New(less)
+	nodes := make([]*Node[int], 0)
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, h.Push(i))
+	}
+	h.Dequeue()
+
+	goassert.True(t, h.RemoveNode(nodes[5]))
+	goassert.False(t, h.RemoveNode(nodes[5]))
+	goassert.False(t, h.RemoveNode(nodes[0]))
+	goassert.DeepEqual(t, []int{1, 2, 3, 4, 6, 7, 8, 9}, h.DequeueN(10))
+}
+
+func Test_DequeueAndPeekShouldPanic_GivenPairingHeapIsEmpty(t *testing.T) {
+	h := New(less)
+
+	_, dequeueErr := h.TryDequeue()
+	_, peekErr := h.TryPeek()
+	_, found := h.DequeueValue()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, dequeueErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, peekErr)
+	goassert.False(t, found)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot Dequeue. PairingHeap is empty", func() {
+		h.Dequeue()
+	})
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot Peek. PairingHeap is empty", func() {
+		h.Peek()
+	})
+}
+
+func Test_RemoveAndContains(t *testing.T) {
+	h := New(less)
+	h.SetEqualityComparer(comparer.DefaultEquals[int])
+	for _, element := range []int{14, 16, 5, 23, 7} {
+		h.Add(element)
+	}
+
+	goassert.True(t, h.Remove(16))
+	goassert.False(t, h.Remove(16))
+	goassert.True(t, h.Contains(23))
+	goassert.False(t, h.Contains(16))
+	goassert.DeepEqual(t, []int{5, 7, 14, 23}, h.DequeueN(4))
+}
+
+func Test_RemoveShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
+	h := New(less)
+
+	_, err := h.TryContains(1)
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot execute Remove. Equality comparer was not set",
+		func() {
+			h.Remove(1)
+		},
+	)
+}
+
+func Test_EqualsAndHashCodeShouldIgnoreStorageOrder(t *testing.T) {
+	h := New(less)
+	h.SetEqualityComparer(comparer.DefaultEquals[int])
+	h.Enqueue(14)
+	h.Enqueue(5)
+	pq := priorityqueue.Heapify([]int{5, 14}, less)
+	pq.SetEqualityComparer(comparer.DefaultEquals[int])
+
+	goassert.True(t, h.Equals(&pq))
+	goassert.True(t, pq.Equals(&h))
+	goassert.Equal(t, pq.HashCode(comparer.DefaultHash[int]), h.HashCode(comparer.DefaultHash[int]))
+}
+
+func Test_ToSortedSliceShouldReturnElementsInPriorityOrder_WithoutModifyingPairingHeap(t *testing.T) {
+	h := New(less)
+	for _, element := range []int{14, 16, 5, 23, 7} {
+		h.Enqueue(element)
+	}
+
+	goassert.DeepEqual(t, []int{5, 7, 14, 16, 23}, h.ToSortedSlice())
+	goassert.DeepEqual(t, []int{5, 7, 14, 16, 23}, h.DequeueN(h.Size()))
+}
+
+func Test_ForEachShouldRestoreOrder_AfterElementsAreUpdated(t *testing.T) {
+	h := New(less)
+	for _, element := range []int{14, 16, 5, 23, 7} {
+		h.Enqueue(element)
+	}
+	h.Dequeue()
+
+	h.ForEach(func(element *int) {
+		*element = 100 - *element
+	})
+
+	goassert.DeepEqual(t, []int{77, 84, 86, 93}, h.DequeueN(4))
+}
+
+func Test_ClearShouldInvalidateNodes(t *testing.T) {
+	h := New(less)
+	node := h.Push(1)
+
+	h.Clear()
+
+	goassert.True(t, h.Empty())
+	goassert.False(t, h.RemoveNode(node))
+}
+
+func Test_PairingHeapShouldMatchSortedModel_GivenRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(5))
+	h := New(less)
+	nodes := make(map[*Node[int]]bool)
+
+	for i := 0; i < 5000; i++ {
+		switch random.Intn(4) {
+		case 0:
+			if !h.Empty() {
+				minimum := -1
+				for node := range nodes {
+					if minimum == -1 || node.Value() < minimum {
+						minimum = node.Value()
+					}
+				}
+				goassert.Equal(t, minimum, *h.Peek())
+				h.Dequeue()
+				for node := range nodes {
+					if !h.owns(node) {
+						delete(nodes, node)
+					}
+				}
+			}
+		case 1:
+			for node := range nodes {
+				h.Update(node, random.Intn(1000))
+				break
+			}
+		case 2:
+			for node := range nodes {
+				goassert.True(t, h.RemoveNode(node))
+				delete(nodes, node)
+				break
+			}
+		default:
+			nodes[h.Push(random.Intn(1000))] = true
+		}
+		goassert.Equal(t, len(nodes), h.Size())
+	}
+
+	expected := make([]int, 0, len(nodes))
+	for node := range nodes {
+		expected = append(expected, node.Value())
+	}
+	sort.Ints(expected)
+	goassert.DeepEqual(t, expected, h.DequeueN(h.Size()))
+}
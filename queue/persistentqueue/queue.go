@@ -0,0 +1,242 @@
+package persistentqueue
+
+import (
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/queue"
+)
+
+/*
+Persistent banker's queue (Okasaki). First element to be enqueued will be dequeued first (FIFO). A Queue is
+immutable: Enqueue and Dequeue return new queues in amortized O(1) time, even when several versions derived
+from the same Queue are used. Elements are kept in a lazily evaluated front stream and a rear list holding the
+recently enqueued elements in reversed order. Whenever the rear list grows longer than the front stream, it is
+scheduled to be reversed and appended to the front stream.
+Since a Queue never changes, it can be shared and read by any number of goroutines without synchronization.
+Elements are handed out as references to copies. The zero value is an empty Queue with nil equality comparer.
+Implements ReadOnlyQueuer and ReadOnlyCollectioner
+*/
+type Queue[T any] struct {
+	equals    func(*T, *T) bool
+	front     *stream[T]
+	frontSize int
+	rear      *list[T]
+	rearSize  int
+}
+
+/*
+Creates a new instance of Queue with the given elements enqueued in order with a default equality comparer
+and returns it.
+If no elements are given, then an empty queue is created. Elements must be comparable
+*/
+func New[K comparable](elements ...K) Queue[K] {
+	return NewOfAny(elements...).WithEqualityComparer(comparer.DefaultEquals[K])
+}
+
+/*
+Creates a new instance of Queue with the given elements enqueued in order with nil equality comparer and
+returns it.
+If no elements are given, then an empty queue is created. Elements can be of any type
+*/
+func NewOfAny[T any](elements ...T) Queue[T] {
+	var q Queue[T]
+	for _, element := range elements {
+		q = q.Enqueue(element)
+	}
+
+	return q
+}
+
+/*
+Returns a copy of the Queue which uses the given equality comparer. The elements are shared
+*/
+func (q Queue[T]) WithEqualityComparer(equals func(*T, *T) bool) Queue[T] {
+	q.equals = equals
+	return q
+}
+
+/*
+Returns the number of elements in the Queue.
+Implements ReadOnlyQueuer.Size and ReadOnlyCollectioner.Size
+*/
+func (q Queue[T]) Size() int {
+	return q.frontSize + q.rearSize
+}
+
+/*
+Returns true if the Queue is empty.
+Implements ReadOnlyQueuer.Empty and ReadOnlyCollectioner.Empty
+*/
+func (q Queue[T]) Empty() bool {
+	return q.Size() == 0
+}
+
+/*
+Returns a new Queue with the given element pushed to the back of the elements of the Queue
+*/
+func (q Queue[T]) Enqueue(element T) Queue[T] {
+	q.rear = &list[T]{value: element, next: q.rear}
+	q.rearSize++
+
+	return q.balanced()
+}
+
+/*
+Returns a new Queue without the element at the front of the Queue. Panics if the Queue is empty
+*/
+func (q Queue[T]) Dequeue() Queue[T] {
+	dequeued, err := q.TryDequeue()
+	if err != nil {
+		panic(err)
+	}
+
+	return dequeued
+}
+
+/*
+Returns a new Queue without the element at the front of the Queue. Returns an error wrapping errors.ErrEmpty if
+the Queue is empty
+*/
+func (q Queue[T]) TryDequeue() (Queue[T], error) {
+	if q.Empty() {
+		return q, errors.Newf(errors.ErrEmpty, "Queue.Dequeue failed because queue is empty")
+	}
+
+	q.front = q.front.force().next
+	q.frontSize--
+
+	return q.balanced(), nil
+}
+
+// keeps the rear list at most as long as the front stream, so that the front is only empty if the Queue is
+func (q Queue[T]) balanced() Queue[T] {
+	if q.rearSize <= q.frontSize {
+		return q
+	}
+
+	q.front = appendReversed(q.front, q.rear)
+	q.frontSize += q.rearSize
+	q.rear = nil
+	q.rearSize = 0
+
+	return q
+}
+
+/*
+Returns a reference to a copy of the element at the front of the Queue. Panics if the Queue is empty.
+Implements ReadOnlyQueuer.Peek
+*/
+func (q Queue[T]) Peek() *T {
+	element, err := q.TryPeek()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to a copy of the element at the front of the Queue. Returns an error wrapping
+errors.ErrEmpty if the Queue is empty.
+Implements ReadOnlyQueuer.TryPeek
+*/
+func (q Queue[T]) TryPeek() (*T, error) {
+	if q.Empty() {
+		return nil, errors.Newf(errors.ErrEmpty, "Queue.Peek failed because queue is empty")
+	}
+
+	element := q.front.force().value
+	return &element, nil
+}
+
+/*
+Returns true if the given element exists in the Queue. Panics if the equality comparer is not set.
+Implements ReadOnlyQueuer.Contains and ReadOnlyCollectioner.Contains
+*/
+func (q Queue[T]) Contains(element T) bool {
+	contains, err := q.TryContains(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return contains
+}
+
+/*
+Returns true if the given element exists in the Queue. Returns an error wrapping errors.ErrNoEqualityComparer
+if the equality comparer is not set.
+Implements ReadOnlyQueuer.TryContains
+*/
+func (q Queue[T]) TryContains(element T) (bool, error) {
+	if err := q.checkEqualityComparer(); err != nil {
+		return false, err
+	}
+
+	for c := q.front.force(); c != nil; c = c.next.force() {
+		if q.equals(&c.value, &element) {
+			return true, nil
+		}
+	}
+	for l := q.rear; l != nil; l = l.next {
+		if q.equals(&l.value, &element) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+/*
+Returns true if the given queue has the same size as the Queue and would dequeue equal elements in the same
+order. Panics if the equality comparer is not set.
+Implements ReadOnlyQueuer.Equals
+*/
+func (q Queue[T]) Equals(other queue.ReadOnlyQueuer[T]) bool {
+	if err := q.checkEqualityComparer(); err != nil {
+		panic(err)
+	}
+
+	return generic.SequenceEqual[T](q, queue.DequeueOrder[T](other), q.equals)
+}
+
+/*
+Returns a hash code which depends on the elements of the Queue and their order.
+Implements ReadOnlyQueuer.HashCode
+*/
+func (q Queue[T]) HashCode(hash func(*T) uint64) uint64 {
+	return generic.OrderedHashCode[T](q, hash)
+}
+
+/*
+Iterates through each element of the Queue from front to back and executes the given function on a reference
+to a copy of the element.
+Implements ReadOnlyQueuer.ForEach and ReadOnlyCollectioner.ForEach
+*/
+func (q Queue[T]) ForEach(do func(*T)) {
+	for c := q.front.force(); c != nil; c = c.next.force() {
+		element := c.value
+		do(&element)
+	}
+
+	rear := make([]T, q.rearSize)
+	i := q.rearSize
+	for l := q.rear; l != nil; l = l.next {
+		i--
+		rear[i] = l.value
+	}
+	for i := range rear {
+		do(&rear[i])
+	}
+}
+
+func (q Queue[T]) checkEqualityComparer() error {
+	if q.equals == nil {
+		return errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		)
+	}
+
+	return nil
+}
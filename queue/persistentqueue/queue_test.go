@@ -0,0 +1,188 @@
+package persistentqueue
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/queue"
+	"github.com/golanglibs/gocollections/queue/linkedlistqueue"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testReadOnlyQueuer[T any](q queue.ReadOnlyQueuer[T]) {}
+
+func testReadOnlyCollectioner[T any](c generic.ReadOnlyCollectioner[T]) {}
+
+func toSlice[T any](q Queue[T]) []T {
+	elements := make([]T, 0, q.Size())
+	q.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return elements
+}
+
+func drain(q Queue[int]) []int {
+	elements := make([]int, 0, q.Size())
+	for !q.Empty() {
+		elements = append(elements, *q.Peek())
+		q = q.Dequeue()
+	}
+
+	return elements
+}
+
+func Test_QueueShouldImplementReadOnlyQueuerAndReadOnlyCollectioner(t *testing.T) {
+	q := New[int]()
+
+	testReadOnlyQueuer[int](q)
+	testReadOnlyCollectioner[int](q)
+}
+
+func Test_New(t *testing.T) {
+	q := New(10, 16, 5)
+
+	goassert.Equal(t, 3, q.Size())
+	goassert.False(t, q.Empty())
+	goassert.Equal(t, 10, *q.Peek())
+	goassert.DeepEqual(t, []int{10, 16, 5}, toSlice(q))
+	goassert.DeepEqual(t, []int{10, 16, 5}, drain(q))
+	goassert.True(t, q.Contains(5))
+	goassert.False(t, q.Contains(1))
+}
+
+func Test_NewOfAny(t *testing.T) {
+	q := NewOfAny([]int{1}, []int{2})
+
+	goassert.Equal(t, 1, (*q.Peek())[0])
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot compute equality of elements since equality comparer is not set",
+		func() {
+			q.Contains([]int{1})
+		},
+	)
+}
+
+func Test_ZeroValueQueueShouldBeEmpty(t *testing.T) {
+	var q Queue[int]
+
+	goassert.True(t, q.Empty())
+	goassert.Equal(t, 10, *q.Enqueue(10).Peek())
+	goassert.True(t, q.Empty())
+}
+
+func Test_QueueEnqueueAndDequeueShouldReturnNewQueues_AndLeaveOldQueuesUntouched(t *testing.T) {
+	versions := []Queue[int]{New[int]()}
+	for i := 0; i < 100; i++ {
+		versions = append(versions, versions[i].Enqueue(i))
+	}
+	for i := 0; i < 100; i++ {
+		versions = append(versions, versions[100+i].Dequeue())
+	}
+
+	for i := 0; i <= 100; i++ {
+		expected := make([]int, 0)
+		for j := 0; j < i; j++ {
+			expected = append(expected, j)
+		}
+		goassert.DeepEqual(t, expected, drain(versions[i]))
+		goassert.DeepEqual(t, expected, toSlice(versions[i]))
+
+		expected = expected[:0]
+		for j := i; j < 100; j++ {
+			expected = append(expected, j)
+		}
+		goassert.DeepEqual(t, expected, drain(versions[100+i]))
+	}
+}
+
+func Test_QueueShouldMatchSliceModel_GivenRandomVersions(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	queues := []Queue[int]{New[int]()}
+	models := [][]int{{}}
+
+	for i := 0; i < 2000; i++ {
+		j := random.Intn(len(queues))
+		q, model := queues[j], models[j]
+		if random.Intn(3) == 0 && !q.Empty() {
+			goassert.Equal(t, model[0], *q.Peek())
+			q, model = q.Dequeue(), model[1:]
+		} else {
+			q, model = q.Enqueue(i), append(append([]int{}, model...), i)
+		}
+		queues, models = append(queues, q), append(models, model)
+	}
+
+	for i := range queues {
+		goassert.Equal(t, len(models[i]), queues[i].Size())
+		goassert.DeepEqual(t, models[i], toSlice(queues[i]))
+	}
+}
+
+func Test_QueueShouldBeReadableConcurrently(t *testing.T) {
+	q := New[int]()
+	for i := 0; i < 1000; i++ {
+		q = q.Enqueue(i)
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]int, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = drain(q)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		goassert.Equal(t, 1000, len(result))
+		goassert.Equal(t, 999, result[999])
+	}
+}
+
+func Test_QueueDequeueAndPeekShouldPanic_GivenQueueIsEmpty(t *testing.T) {
+	q := New[int]()
+
+	_, dequeueErr := q.TryDequeue()
+	_, peekErr := q.TryPeek()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, dequeueErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, peekErr)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Queue.Dequeue failed because queue is empty", func() {
+		q.Dequeue()
+	})
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Queue.Peek failed because queue is empty", func() {
+		q.Peek()
+	})
+}
+
+func Test_QueuePeekAndForEachShouldReturnReferencesToCopies(t *testing.T) {
+	q := New(10, 16, 5)
+
+	*q.Peek() = 1
+	q.ForEach(func(element *int) {
+		*element = 1
+	})
+
+	goassert.DeepEqual(t, []int{10, 16, 5}, toSlice(q))
+}
+
+func Test_QueueEqualsAndHashCode(t *testing.T) {
+	q := New(10, 16, 5)
+	other := linkedlistqueue.New(10, 16, 5)
+
+	goassert.True(t, q.Equals(&other))
+	goassert.True(t, q.Equals(New(1, 10, 16).Dequeue().Enqueue(5)))
+	goassert.False(t, q.Equals(New(5, 16, 10)))
+	goassert.False(t, q.Equals(q.Dequeue()))
+	goassert.Equal(t, other.HashCode(comparer.DefaultHash[int]), q.HashCode(comparer.DefaultHash[int]))
+}
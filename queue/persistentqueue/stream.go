@@ -0,0 +1,84 @@
+package persistentqueue
+
+import "sync"
+
+/*
+Lazily evaluated, memoized list. The front of a Queue is a stream, so that the reversal of the rear list is
+only paid once even if it is forced through several versions of the Queue, which keeps the operations of every
+version amortized O(1). Evaluation is guarded by a sync.Once, so versions sharing a stream can be read
+concurrently
+*/
+type stream[T any] struct {
+	once      sync.Once
+	suspense  func() *cell[T]
+	evaluated *cell[T]
+}
+
+type cell[T any] struct {
+	value T
+	next  *stream[T]
+}
+
+/*
+Rear list of a Queue. Elements are linked from the most recently enqueued one
+*/
+type list[T any] struct {
+	value T
+	next  *list[T]
+}
+
+/*
+Returns the first cell of the stream or nil if it is empty. A nil stream is empty
+*/
+func (s *stream[T]) force() *cell[T] {
+	if s == nil {
+		return nil
+	}
+
+	s.once.Do(func() {
+		s.evaluated = s.suspense()
+		// the evaluated cell is all that is needed from now on, so the suspension and everything it captured
+		// can be garbage collected
+		s.suspense = nil
+	})
+
+	return s.evaluated
+}
+
+/*
+Returns a stream of the elements of the given stream followed by the elements of the given rear list in
+reversed order. Cells of "front" are copied one at a time as the result is forced, and "rear" is reversed at
+once when the end of "front" is reached
+*/
+func appendReversed[T any](front *stream[T], rear *list[T]) *stream[T] {
+	return &stream[T]{
+		suspense: func() *cell[T] {
+			first := front.force()
+			if first == nil {
+				return reverse(rear)
+			}
+
+			return &cell[T]{value: first.value, next: appendReversed(first.next, rear)}
+		},
+	}
+}
+
+func reverse[T any](rear *list[T]) *cell[T] {
+	var reversed *cell[T]
+	for l := rear; l != nil; l = l.next {
+		reversed = &cell[T]{value: l.value, next: evaluatedStream(reversed)}
+	}
+
+	return reversed
+}
+
+func evaluatedStream[T any](c *cell[T]) *stream[T] {
+	if c == nil {
+		return nil
+	}
+
+	s := &stream[T]{evaluated: c}
+	s.once.Do(func() {})
+
+	return s
+}
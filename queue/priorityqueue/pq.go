@@ -1,25 +1,109 @@
 package priorityqueue
 
+import (
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/queue"
+)
+
 /*
 Binary Heap. It uses gocollections/list/arraylist to perform operations
+The heap can be made d-ary through the Arity option. A higher arity makes the heap shallower and keeps the
+children of a node next to each other in memory, which speeds up Enqueue and benefits very large queues, at the
+cost of more comparisons per level in Dequeue
 Implements Queuer and Collectioner
 "SetEqualityComparer" method is required for "Remove" and "Contains" methods to work properly
+Elements with equal priorities are dequeued in an arbitrary order unless the PriorityQueue is created with the
+Stable option, in which case they are dequeued in the order they were enqueued
 PriorityQueue is not thread safe
 */
 type PriorityQueue[T any] struct {
-	equals    func(*T, *T) bool
-	compare   func(*T, *T) bool
-	container []T
-	cap       int
-	size      int
+	equals        func(*T, *T) bool
+	compare       func(*T, *T) bool
+	container     []T
+	sequence      []uint64
+	arity         int
+	nextSequence  uint64
+	cap           int
+	size          int
+	marshalSorted bool
+}
+
+/*
+Configures a PriorityQueue created through New or Heapify
+*/
+type Option func(*options)
+
+type options struct {
+	stable bool
+	arity  int
+}
+
+const defaultArity = 2
+
+/*
+Makes the PriorityQueue stable: elements with equal priorities, which means neither "compare(e0, e1)" nor
+"compare(e1, e0)" returns true, are dequeued in the order they were enqueued. Each element is tagged with an
+insertion sequence number which breaks the ties, at the cost of 8 extra bytes per element
+*/
+func Stable() Option {
+	return func(o *options) {
+		o.stable = true
+	}
+}
+
+/*
+Makes the PriorityQueue a d-ary heap in which every node has up to "arity" children instead of two. 4 and 8
+pay off mostly for large queues with more Enqueue than Dequeue calls, which the benchmarks of the package
+compare. Panics with an error wrapping errors.ErrInvalidArgument if the given arity is less than 2
+*/
+func Arity(arity int) Option {
+	if arity < 2 {
+		panic(errors.Newf(errors.ErrInvalidArgument, "Arity of a PriorityQueue must be at least 2 but %d was given", arity))
+	}
+
+	return func(o *options) {
+		o.arity = arity
+	}
+}
+
+/*
+Returns true if the element at "i" has a higher priority than the element at "j". In stable mode ("sequence" is
+not nil), ties are broken by the insertion sequence numbers so that the earlier element wins
+*/
+func higher[T any](heap []T, sequence []uint64, i int, j int, compare func(*T, *T) bool) bool {
+	if compare(&heap[i], &heap[j]) {
+		return true
+	}
+	if sequence == nil {
+		return false
+	}
+
+	return sequence[i] < sequence[j] && !compare(&heap[j], &heap[i])
+}
+
+func swap[T any](heap []T, sequence []uint64, i int, j int) {
+	heap[i], heap[j] = heap[j], heap[i]
+	if sequence != nil {
+		sequence[i], sequence[j] = sequence[j], sequence[i]
+	}
 }
 
-func siftUp[T any](heap []T, size int, compare func(*T, *T) bool) {
+// the heap is 1-based, so the children of "i" are at indices arity*(i-1)+2 to arity*(i-1)+arity+1
+func parentOf(i int, arity int) int {
+	return (i-2)/arity + 1
+}
+
+func firstChildOf(i int, arity int) int {
+	return arity*(i-1) + 2
+}
+
+func siftUp[T any](heap []T, sequence []uint64, size int, arity int, compare func(*T, *T) bool) {
 	i := size
 	for i > 1 {
-		parent := i >> 1
-		if compare(&heap[i], &heap[parent]) {
-			heap[i], heap[parent] = heap[parent], heap[i]
+		parent := parentOf(i, arity)
+		if higher(heap, sequence, i, parent, compare) {
+			swap(heap, sequence, i, parent)
 		} else {
 			break
 		}
@@ -28,16 +112,34 @@ func siftUp[T any](heap []T, size int, compare func(*T, *T) bool) {
 	}
 }
 
-func siftDown[T any](start int, heap []T, size int, compare func(*T, *T) bool) {
+func heapify[T any](heap []T, sequence []uint64, size int, arity int, compare func(*T, *T) bool) {
+	if size < 2 {
+		return
+	}
+
+	for i := parentOf(size, arity); i > 0; i-- {
+		siftDown(i, heap, sequence, size, arity, compare)
+	}
+}
+
+func siftDown[T any](start int, heap []T, sequence []uint64, size int, arity int, compare func(*T, *T) bool) {
 	i := start
-	for (i << 1) <= size {
-		child := i << 1
-		if child+1 <= size && compare(&heap[child+1], &heap[child]) {
-			child++
+	for firstChildOf(i, arity) <= size {
+		first := firstChildOf(i, arity)
+		last := first + arity - 1
+		if last > size {
+			last = size
+		}
+
+		child := first
+		for candidate := first + 1; candidate <= last; candidate++ {
+			if higher(heap, sequence, candidate, child, compare) {
+				child = candidate
+			}
 		}
 
-		if compare(&heap[child], &heap[i]) {
-			heap[child], heap[i] = heap[i], heap[child]
+		if higher(heap, sequence, child, i, compare) {
+			swap(heap, sequence, child, i)
 		} else {
 			break
 		}
@@ -49,37 +151,85 @@ func siftDown[T any](start int, heap []T, size int, compare func(*T, *T) bool) {
 /*
 Initializes a new instance of empty PriorityQueue and returns it. "compare" function must be passed in order
 to compare elements. If the "compare(e0, e1)" returns true, it means "e0" will have higher priority than "e1"
-and will appear earlier than "e1" when dequeueing or popping the top elements
+and will appear earlier than "e1" when dequeueing or popping the top elements. Options such as Stable and Arity
+can be given to configure the PriorityQueue
 */
-func New[T any](compare func(*T, *T) bool) PriorityQueue[T] {
+func New[T any](compare func(*T, *T) bool, opts ...Option) PriorityQueue[T] {
 	var filler T
-	return PriorityQueue[T]{
+	pq := PriorityQueue[T]{
 		compare:   compare,
 		container: []T{filler},
 		cap:       0,
 		size:      0,
 	}
+	pq.configure(opts)
+
+	return pq
 }
 
 /*
-Makes a copy of the given slice and heapifies it
+Makes a copy of the given slice and heapifies it. In stable mode, elements with equal priorities are dequeued
+in the order they appear in the given slice
 */
-func Heapify[T any](elements []T, compare func(*T, *T) bool) PriorityQueue[T] {
+func Heapify[T any](elements []T, compare func(*T, *T) bool, opts ...Option) PriorityQueue[T] {
 	size := len(elements)
 	container := make([]T, size+1)
-	copy(container, elements)
-	container[0], container[size] = container[size], container[0]
+	copy(container[1:], elements)
 
-	for i := size >> 1; i > 0; i-- {
-		siftDown(i, container, size, compare)
-	}
-
-	return PriorityQueue[T]{
+	pq := PriorityQueue[T]{
 		compare:   compare,
 		container: container,
 		cap:       size,
 		size:      size,
 	}
+	pq.configure(opts)
+	pq.resequence()
+	heapify(pq.container, pq.sequence, size, pq.arity, compare)
+
+	return pq
+}
+
+func (pq *PriorityQueue[T]) configure(opts []Option) {
+	o := options{arity: defaultArity}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	pq.arity = o.arity
+	if o.stable {
+		pq.sequence = make([]uint64, len(pq.container))
+	}
+}
+
+/*
+Numbers the elements of a container which was just filled in the order they appear. Does nothing unless the
+PriorityQueue is stable
+*/
+func (pq *PriorityQueue[T]) resequence() {
+	if pq.sequence == nil {
+		return
+	}
+
+	if len(pq.sequence) < len(pq.container) {
+		pq.sequence = make([]uint64, len(pq.container))
+	}
+	for i := 1; i <= pq.size; i++ {
+		pq.sequence[i] = uint64(i)
+	}
+	pq.nextSequence = uint64(pq.size) + 1
+}
+
+/*
+Returns the elements in the order the codecs encode them. Decoding numbers the elements in the order they were
+encoded, so a stable PriorityQueue is encoded in priority order to keep equal elements in the order they were
+enqueued. Otherwise, the elements are encoded in internal heap order, which needs no extra work
+*/
+func (pq *PriorityQueue[T]) encodingOrder() []T {
+	if pq.sequence != nil {
+		return pq.ToSortedSlice()
+	}
+
+	return pq.container[1 : pq.size+1]
 }
 
 /*
@@ -114,12 +264,20 @@ func (pq *PriorityQueue[T]) Enqueue(element T) {
 	pq.size++
 	if pq.cap < pq.size {
 		pq.container = append(pq.container, element)
+		if pq.sequence != nil {
+			pq.sequence = append(pq.sequence, 0)
+		}
 		pq.cap++
 	} else {
 		pq.container[pq.size] = element
 	}
 
-	siftUp(pq.container, pq.size, pq.compare)
+	if pq.sequence != nil {
+		pq.sequence[pq.size] = pq.nextSequence
+		pq.nextSequence++
+	}
+
+	siftUp(pq.container, pq.sequence, pq.size, pq.arity, pq.compare)
 }
 
 /*
@@ -127,13 +285,48 @@ Removes the top element (with the highest priority) in the PriorityQueue.
 Implements Queuer.Dequeue
 */
 func (pq *PriorityQueue[T]) Dequeue() {
+	if _, err := pq.TryDequeue(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the top element (with the highest priority) in the PriorityQueue and returns it. Returns an error
+wrapping errors.ErrEmpty if the PriorityQueue is empty.
+Implements Queuer.TryDequeue
+*/
+func (pq *PriorityQueue[T]) TryDequeue() (T, error) {
 	if pq.size == 0 {
-		panic("Cannot Dequeue. PriorityQueue is empty")
+		var zero T
+		return zero, errors.Newf(errors.ErrEmpty, "Cannot Dequeue. PriorityQueue is empty")
 	}
 
-	pq.container[1], pq.container[pq.size] = pq.container[pq.size], pq.container[1]
+	element := pq.container[1]
+	swap(pq.container, pq.sequence, 1, pq.size)
 	pq.size--
-	siftDown(1, pq.container, pq.size, pq.compare)
+	siftDown(1, pq.container, pq.sequence, pq.size, pq.arity, pq.compare)
+
+	return element, nil
+}
+
+/*
+Removes the top element (with the highest priority) in the PriorityQueue and returns it along with true.
+Returns the zero value and false if the PriorityQueue is empty.
+Implements Queuer.DequeueValue
+*/
+func (pq *PriorityQueue[T]) DequeueValue() (T, bool) {
+	element, err := pq.TryDequeue()
+
+	return element, err == nil
+}
+
+/*
+Removes up to n elements with the highest priorities and returns them in priority order. Returns fewer than n
+elements if the PriorityQueue runs out of elements, and an empty slice if n is not positive.
+Implements Queuer.DequeueN
+*/
+func (pq *PriorityQueue[T]) DequeueN(n int) []T {
+	return generic.TakeN(n, pq.Size(), pq.TryDequeue)
 }
 
 /*
@@ -141,11 +334,25 @@ Returns a reference to the top element (with the highest priority) without remov
 Implements Queuer.Peek
 */
 func (pq *PriorityQueue[T]) Peek() *T {
+	element, err := pq.TryPeek()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the top element (with the highest priority) without removing it. Returns an error
+wrapping errors.ErrEmpty if the PriorityQueue is empty.
+Implements Queuer.TryPeek
+*/
+func (pq *PriorityQueue[T]) TryPeek() (*T, error) {
 	if pq.size == 0 {
-		panic("Cannot Peek. PriorityQueue is empty")
+		return nil, errors.Newf(errors.ErrEmpty, "Cannot Peek. PriorityQueue is empty")
 	}
 
-	return &pq.container[1]
+	return &pq.container[1], nil
 }
 
 /*
@@ -164,8 +371,22 @@ correct order. Time complexity is O(log n).
 Implements Collectioner.Remove
 */
 func (pq *PriorityQueue[T]) Remove(element T) bool {
+	removed, err := pq.TryRemove(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return removed
+}
+
+/*
+Removes the first occurrence of the given value. Returns true if an element of the same value was found and
+removed. If not, returns false. Returns an error wrapping errors.ErrNoEqualityComparer if the equality
+comparer was not set
+*/
+func (pq *PriorityQueue[T]) TryRemove(element T) (bool, error) {
 	if pq.equals == nil {
-		panic("Cannot Remove. Equality comparer was not set")
+		return false, errors.Newf(errors.ErrNoEqualityComparer, "Cannot Remove. Equality comparer was not set")
 	}
 
 	i := 1
@@ -176,14 +397,18 @@ func (pq *PriorityQueue[T]) Remove(element T) bool {
 	}
 
 	if i > pq.size {
-		return false
+		return false, nil
 	}
 
-	pq.container[i], pq.container[pq.size] = pq.container[pq.size], pq.container[i]
+	swap(pq.container, pq.sequence, i, pq.size)
 	pq.size--
-	siftDown(i, pq.container, pq.size, pq.compare)
+	if i <= pq.size {
+		// the element moved into the gap may belong above it as well as below it
+		siftDown(i, pq.container, pq.sequence, pq.size, pq.arity, pq.compare)
+		siftUp(pq.container, pq.sequence, i, pq.arity, pq.compare)
+	}
 
-	return true
+	return true, nil
 }
 
 /*
@@ -191,17 +416,60 @@ Returns true if an element with the same value as the given value exists. Otherw
 Implements Collectioner.Contains
 */
 func (pq *PriorityQueue[T]) Contains(element T) bool {
+	contains, err := pq.TryContains(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return contains
+}
+
+/*
+Returns true if an element with the same value as the given value exists. Otherwise, returns false.
+Returns an error wrapping errors.ErrNoEqualityComparer if the equality comparer was not set.
+Implements Queuer.TryContains
+*/
+func (pq *PriorityQueue[T]) TryContains(element T) (bool, error) {
 	if pq.equals == nil {
-		panic("Cannot execute Contains. Equality comparer was not set")
+		return false, errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot execute Contains. Equality comparer was not set",
+		)
 	}
 
 	for i := 1; i <= pq.size; i++ {
 		if pq.equals(&pq.container[i], &element) {
-			return true
+			return true, nil
 		}
 	}
 
-	return false
+	return false, nil
+}
+
+/*
+Returns true if the given queue has the same size and would dequeue equal elements in the same order, like
+queue.Equal. The elements of the PriorityQueue are compared in priority order through ToSortedSlice, so the
+order they are stored in does not matter. Elements with equal priorities are compared in the order they would be
+dequeued, which depends on the order they were enqueued. Equality is determined by the equality comparer. Panics
+if the equality comparer was not set. Time complexity is O(n log n).
+Implements Queuer.Equals
+*/
+func (pq *PriorityQueue[T]) Equals(other queue.ReadOnlyQueuer[T]) bool {
+	if pq.equals == nil {
+		panic(errors.Newf(errors.ErrNoEqualityComparer, "Cannot execute Equals. Equality comparer was not set"))
+	}
+
+	return queue.Equal[T](pq, other, pq.equals)
+}
+
+/*
+Returns a hash code which depends on the elements of the PriorityQueue and the order they would be dequeued in,
+but not on the order they are stored in. Elements are hashed with the given function, which must return equal
+hashes for elements the equality comparer considers equal.
+Implements Queuer.HashCode
+*/
+func (pq *PriorityQueue[T]) HashCode(hash func(*T) uint64) uint64 {
+	return queue.HashCode[T](pq, hash)
 }
 
 /*
@@ -224,7 +492,5 @@ func (pq *PriorityQueue[T]) ForEach(do func(*T)) {
 		do(&pq.container[i])
 	}
 
-	for i := pq.size >> 1; i > 0; i-- {
-		siftDown(i, pq.container, pq.size, pq.compare)
-	}
+	heapify(pq.container, pq.sequence, pq.size, pq.arity, pq.compare)
 }
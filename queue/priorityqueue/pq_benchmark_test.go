@@ -0,0 +1,73 @@
+package priorityqueue
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var benchmarkArities = []int{2, 4, 8}
+
+var benchmarkSizes = []int{1 << 10, 1 << 20}
+
+func randomInts(n int) []int {
+	random := rand.New(rand.NewSource(1))
+	elements := make([]int, n)
+	for i := range elements {
+		elements[i] = random.Int()
+	}
+
+	return elements
+}
+
+// Enqueue only walks up the heap, so it gets cheaper as the heap gets shallower
+func Benchmark_Enqueue(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		elements := randomInts(size)
+		for _, arity := range benchmarkArities {
+			b.Run(fmt.Sprintf("size=%d/arity=%d", size, arity), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					pq := New(lessInt, Arity(arity))
+					for _, element := range elements {
+						pq.Enqueue(element)
+					}
+				}
+			})
+		}
+	}
+}
+
+// Dequeue compares all children of every node on its way down, trading comparisons for fewer cache misses
+func Benchmark_HeapifyAndDequeueAll(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		elements := randomInts(size)
+		for _, arity := range benchmarkArities {
+			b.Run(fmt.Sprintf("size=%d/arity=%d", size, arity), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					pq := Heapify(elements, lessInt, Arity(arity))
+					for !pq.Empty() {
+						pq.Dequeue()
+					}
+				}
+			})
+		}
+	}
+}
+
+// a queue which stays large while elements flow through it, like the frontier of a graph search
+func Benchmark_EnqueueDequeueAtSteadySize(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		elements := randomInts(size)
+		for _, arity := range benchmarkArities {
+			b.Run(fmt.Sprintf("size=%d/arity=%d", size, arity), func(b *testing.B) {
+				pq := Heapify(elements, lessInt, Arity(arity))
+				random := rand.New(rand.NewSource(2))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					top, _ := pq.DequeueValue()
+					pq.Enqueue(top + random.Intn(1<<20))
+				}
+			})
+		}
+	}
+}
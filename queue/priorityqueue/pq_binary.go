@@ -0,0 +1,72 @@
+package priorityqueue
+
+import (
+	"github.com/golanglibs/gocollections/codec"
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Encodes the PriorityQueue into a binary payload made of a versioned header (collection kind and element
+count) followed by the gob encoding of its elements in internal heap order, or in priority order if the
+PriorityQueue is stable so that equal elements keep their order after decoding. A zero value PriorityQueue is
+encoded without elements.
+Implements encoding.BinaryMarshaler
+*/
+func (pq PriorityQueue[T]) MarshalBinary() ([]byte, error) {
+	if pq.container == nil {
+		return codec.MarshalElements(codec.KindPriorityQueue, []T{})
+	}
+
+	return codec.MarshalElements(codec.KindPriorityQueue, pq.encodingOrder())
+}
+
+/*
+Decodes a payload produced by PriorityQueue.MarshalBinary and replaces the elements of the PriorityQueue with
+the decoded elements, which are heapified again with the compare function of the PriorityQueue. Returns an
+error if the payload is corrupted or was produced by a different kind of collection, and an error wrapping
+errors.ErrNoComparator if the PriorityQueue was not created through New or Heapify and therefore has no
+compare function.
+Implements encoding.BinaryUnmarshaler
+*/
+func (pq *PriorityQueue[T]) UnmarshalBinary(data []byte) error {
+	if pq.compare == nil {
+		return errors.Newf(
+			errors.ErrNoComparator,
+			"PriorityQueue.UnmarshalBinary failed because compare function is not set",
+		)
+	}
+
+	elements, err := codec.UnmarshalElements[T](codec.KindPriorityQueue, data)
+	if err != nil {
+		return err
+	}
+
+	size := len(elements)
+	container := make([]T, size+1)
+	copy(container[1:], elements)
+
+	pq.container = container
+	pq.cap = size
+	pq.size = size
+	pq.resequence()
+	heapify(pq.container, pq.sequence, size, pq.arity, pq.compare)
+
+	return nil
+}
+
+/*
+Encodes the PriorityQueue in the same format as PriorityQueue.MarshalBinary.
+Implements gob.GobEncoder
+*/
+func (pq PriorityQueue[T]) GobEncode() ([]byte, error) {
+	return pq.MarshalBinary()
+}
+
+/*
+Decodes a payload produced by PriorityQueue.GobEncode. The same restrictions as
+PriorityQueue.UnmarshalBinary apply.
+Implements gob.GobDecoder
+*/
+func (pq *PriorityQueue[T]) GobDecode(data []byte) error {
+	return pq.UnmarshalBinary(data)
+}
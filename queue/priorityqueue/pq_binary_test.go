@@ -0,0 +1,71 @@
+package priorityqueue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/codec"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_BinaryRoundTripShouldPreservePriorityOrder(t *testing.T) {
+	original := Heapify([]testhelpers.MockStruct{{Prop: 14}, {Prop: 16}, {Prop: 5}, {Prop: 23}}, compare)
+
+	encoded, err := original.MarshalBinary()
+	goassert.Nil(t, err)
+
+	decoded := New(compare)
+	err = decoded.UnmarshalBinary(encoded)
+
+	goassert.Nil(t, err)
+	verifyPq(t, []testhelpers.MockStruct{{Prop: 5}, {Prop: 14}, {Prop: 16}, {Prop: 23}}, &decoded)
+}
+
+func Test_MarshalBinaryShouldEncodeNoElements_GivenZeroValuePriorityQueue(t *testing.T) {
+	var original PriorityQueue[testhelpers.MockStruct]
+
+	encoded, err := original.MarshalBinary()
+	goassert.Nil(t, err)
+
+	decoded := New(compare)
+	decoded.Enqueue(data(1))
+	err = decoded.UnmarshalBinary(encoded)
+
+	goassert.Nil(t, err)
+	goassert.True(t, decoded.Empty())
+}
+
+func Test_UnmarshalBinaryShouldReturnError_IfCompareIsNotSet(t *testing.T) {
+	original := Heapify([]testhelpers.MockStruct{{Prop: 14}}, compare)
+	encoded, _ := original.MarshalBinary()
+
+	var decoded PriorityQueue[testhelpers.MockStruct]
+	err := decoded.UnmarshalBinary(encoded)
+
+	testhelpers.ErrorIs(t, errors.ErrNoComparator, err)
+	goassert.Equal(t, "PriorityQueue.UnmarshalBinary failed because compare function is not set", err.Error())
+}
+
+func Test_UnmarshalBinaryShouldReturnErrKindMismatch_GivenPayloadOfOtherCollection(t *testing.T) {
+	encoded, _ := codec.MarshalElements(codec.KindArrayList, []testhelpers.MockStruct{{Prop: 1}})
+
+	decoded := New(compare)
+	err := decoded.UnmarshalBinary(encoded)
+
+	testhelpers.ErrorIs(t, codec.ErrKindMismatch, err)
+}
+
+func Test_GobShouldEncodeAndDecodeIntoPriorityQueueWithCompare(t *testing.T) {
+	original := Heapify([]testhelpers.MockStruct{{Prop: 14}, {Prop: 5}, {Prop: 10}}, compare)
+
+	var buffer bytes.Buffer
+	goassert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+
+	decoded := New(compare)
+	goassert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+
+	verifyPq(t, []testhelpers.MockStruct{{Prop: 5}, {Prop: 10}, {Prop: 14}}, &decoded)
+}
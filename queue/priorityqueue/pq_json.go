@@ -0,0 +1,68 @@
+package priorityqueue
+
+import (
+	"encoding/json"
+
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Sets whether MarshalJSON encodes the elements in priority order (the order they would be dequeued) instead of
+the internal heap order. Encoding in priority order costs an extra copy and O(n log n) time
+*/
+func (pq *PriorityQueue[T]) SetMarshalSorted(sorted bool) {
+	pq.marshalSorted = sorted
+}
+
+/*
+Encodes the PriorityQueue as a JSON array of its elements. The elements appear in internal heap order unless
+sorted encoding was enabled through PriorityQueue.SetMarshalSorted or the PriorityQueue is stable, in which case
+they appear in the order they would be dequeued, so equal elements keep their order after decoding. In all cases,
+the first element of the array is the one with the highest priority. A zero value PriorityQueue is encoded as an
+empty array.
+Implements json.Marshaler
+*/
+func (pq PriorityQueue[T]) MarshalJSON() ([]byte, error) {
+	if pq.container == nil {
+		return []byte("[]"), nil
+	}
+
+	if pq.marshalSorted {
+		return json.Marshal(pq.ToSortedSlice())
+	}
+
+	return json.Marshal(pq.encodingOrder())
+}
+
+/*
+Decodes the given JSON array and replaces the elements of the PriorityQueue with the decoded elements. The
+decoded elements are heapified with the compare function of the PriorityQueue, so the array does not need to
+be in heap order. Returns an error wrapping errors.ErrNoComparator if the PriorityQueue was not created through
+New or Heapify and therefore has no compare function.
+Implements json.Unmarshaler
+*/
+func (pq *PriorityQueue[T]) UnmarshalJSON(data []byte) error {
+	if pq.compare == nil {
+		return errors.Newf(
+			errors.ErrNoComparator,
+			"PriorityQueue.UnmarshalJSON failed because compare function is not set",
+		)
+	}
+
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	size := len(elements)
+	container := make([]T, size+1)
+	copy(container[1:], elements)
+
+	pq.container = container
+	pq.cap = size
+	pq.size = size
+	pq.resequence()
+	heapify(pq.container, pq.sequence, size, pq.arity, pq.compare)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package priorityqueue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_MarshalJSONShouldEncodeElementsInHeapOrder_ByDefault(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{{Prop: 14}, {Prop: 16}, {Prop: 5}, {Prop: 7}}, compare)
+
+	data, err := json.Marshal(pq)
+	goassert.Nil(t, err)
+
+	var elements []testhelpers.MockStruct
+	goassert.Nil(t, json.Unmarshal(data, &elements))
+	goassert.DeepEqual(t, pq.container[1:], elements)
+	goassert.Equal(t, 5, elements[0].Prop)
+}
+
+func Test_MarshalJSONShouldEncodeElementsInPriorityOrder_WhenMarshalSortedIsSet(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{{Prop: 14}, {Prop: 16}, {Prop: 5}, {Prop: 23}, {Prop: 7}}, compare)
+	pq.SetMarshalSorted(true)
+
+	data, err := json.Marshal(pq)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, `[{"Prop":5},{"Prop":7},{"Prop":14},{"Prop":16},{"Prop":23}]`, string(data))
+	goassert.Equal(t, 5, pq.Size())
+	goassert.Equal(t, 5, pq.Peek().Prop)
+}
+
+func Test_MarshalJSONShouldEncodeEmptyArray_GivenEmptyPriorityQueue(t *testing.T) {
+	pq := New(compare)
+	pq.Enqueue(data(10))
+	pq.Dequeue()
+
+	encoded, err := json.Marshal(pq)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[]", string(encoded))
+}
+
+func Test_MarshalJSONShouldEncodeEmptyArray_GivenZeroValuePriorityQueue(t *testing.T) {
+	var pq PriorityQueue[int]
+	var sorted PriorityQueue[int]
+	sorted.SetMarshalSorted(true)
+
+	encoded, err := json.Marshal(pq)
+	encodedSorted, sortedErr := json.Marshal(sorted)
+
+	goassert.Nil(t, err)
+	goassert.Nil(t, sortedErr)
+	goassert.Equal(t, "[]", string(encoded))
+	goassert.Equal(t, "[]", string(encodedSorted))
+}
+
+func Test_UnmarshalJSONShouldReplaceAndHeapifyElements(t *testing.T) {
+	pq := New(compare)
+	pq.Enqueue(data(1))
+
+	err := json.Unmarshal([]byte(`[{"Prop":14},{"Prop":16},{"Prop":5},{"Prop":23}]`), &pq)
+
+	goassert.Nil(t, err)
+	verifyPq(t, []testhelpers.MockStruct{{Prop: 5}, {Prop: 14}, {Prop: 16}, {Prop: 23}}, &pq)
+}
+
+func Test_UnmarshalJSONShouldReturnError_IfCompareIsNotSet(t *testing.T) {
+	var pq PriorityQueue[testhelpers.MockStruct]
+
+	err := json.Unmarshal([]byte(`[{"Prop":14}]`), &pq)
+
+	testhelpers.ErrorIs(t, errors.ErrNoComparator, err)
+	goassert.Equal(t, "PriorityQueue.UnmarshalJSON failed because compare function is not set", err.Error())
+}
+
+func Test_JSONRoundTripShouldPreserveElements_ButNotEqualityComparer(t *testing.T) {
+	original := Heapify([]testhelpers.MockStruct{{Prop: 14}, {Prop: 16}, {Prop: 5}}, compare)
+	original.SetEqualityComparer(equals)
+
+	encoded, err := json.Marshal(original)
+	goassert.Nil(t, err)
+
+	decoded := New(compare)
+	err = json.Unmarshal(encoded, &decoded)
+
+	goassert.Nil(t, err)
+	goassert.Nil(t, decoded.equals)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot execute Contains. Equality comparer was not set",
+		func() { decoded.Contains(data(5)) },
+	)
+	verifyPq(t, []testhelpers.MockStruct{{Prop: 5}, {Prop: 14}, {Prop: 16}}, &decoded)
+}
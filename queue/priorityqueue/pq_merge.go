@@ -0,0 +1,61 @@
+package priorityqueue
+
+import (
+	"github.com/golanglibs/gocollections/list"
+	"github.com/golanglibs/gocollections/list/arraylist"
+)
+
+// position of the next element to merge from one of the lists given to MergeSorted
+type mergeCursor[T any] struct {
+	elements []T
+	next     int
+	list     int
+}
+
+/*
+Merges the given lists, each of which must already be sorted in priority order according to "compare", into a
+new ArrayList sorted in the same order. The merge is stable: elements with equal priorities keep their order,
+and elements of earlier lists come before elements of later lists. A heap holding the next element of every
+list is built with Heapify, so merging n elements from k lists takes O(n log k) time. The returned ArrayList
+has no equality comparer
+*/
+func MergeSorted[T any](compare func(*T, *T) bool, lists ...list.ReadOnlyLister[T]) arraylist.List[T] {
+	total := 0
+	heap := make([]mergeCursor[T], 1, len(lists)+1)
+	for i, l := range lists {
+		if l.Empty() {
+			continue
+		}
+
+		elements := make([]T, 0, l.Size())
+		l.ForEach(func(element *T) {
+			elements = append(elements, *element)
+		})
+		heap = append(heap, mergeCursor[T]{elements: elements, list: i})
+		total += len(elements)
+	}
+
+	higherCursor := func(a *mergeCursor[T], b *mergeCursor[T]) bool {
+		aNext := &a.elements[a.next]
+		bNext := &b.elements[b.next]
+
+		return compare(aNext, bNext) || (a.list < b.list && !compare(bNext, aNext))
+	}
+
+	size := len(heap) - 1
+	heapify(heap, nil, size, defaultArity, higherCursor)
+
+	merged := make([]T, 0, total)
+	for size > 0 {
+		top := &heap[1]
+		merged = append(merged, top.elements[top.next])
+		top.next++
+		if top.next == len(top.elements) {
+			swap(heap, nil, 1, size)
+			size--
+		}
+		siftDown(1, heap, nil, size, defaultArity, higherCursor)
+	}
+
+	return arraylist.NewOfAny(merged...)
+}
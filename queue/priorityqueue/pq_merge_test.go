@@ -0,0 +1,82 @@
+package priorityqueue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/list"
+	"github.com/golanglibs/gocollections/list/arraylist"
+	"github.com/golanglibs/gocollections/list/doublylinkedlist"
+)
+
+func Test_MergeSortedShouldMergeSortedLists(t *testing.T) {
+	a := arraylist.New(1, 4, 9)
+	b := doublylinkedlist.New(2, 3, 10, 11)
+	empty := arraylist.New[int]()
+	c := arraylist.New(0, 5)
+
+	merged := MergeSorted[int](comparer.Natural[int]().Less(), &a, &b, &empty, &c)
+
+	expected := arraylist.New(0, 1, 2, 3, 4, 5, 9, 10, 11)
+	merged.SetEqualityComparer(comparer.DefaultEquals[int])
+	goassert.True(t, merged.Equals(&expected))
+}
+
+func Test_MergeSortedShouldMergeInDescendingOrder_GivenGreaterFunction(t *testing.T) {
+	a := arraylist.New(9, 4, 1)
+	b := arraylist.New(10, 3)
+
+	merged := MergeSorted[int](comparer.Natural[int]().Greater(), &a, &b)
+
+	goassert.Equal(t, 5, merged.Size())
+	goassert.Equal(t, 10, *merged.Front())
+	goassert.Equal(t, 1, *merged.Back())
+}
+
+func Test_MergeSortedShouldReturnEmptyList_GivenNoLists(t *testing.T) {
+	merged := MergeSorted[int](comparer.Natural[int]().Less())
+
+	goassert.True(t, merged.Empty())
+}
+
+func Test_MergeSortedShouldBeStable(t *testing.T) {
+	byPriority := func(a *job, b *job) bool { return a.priority < b.priority }
+	a := arraylist.NewOfAny(job{priority: 1, id: 0}, job{priority: 1, id: 1}, job{priority: 2, id: 2})
+	b := arraylist.NewOfAny(job{priority: 0, id: 3}, job{priority: 1, id: 4}, job{priority: 2, id: 5})
+
+	merged := MergeSorted[job](byPriority, &a, &b)
+
+	ids := make([]int, 0, merged.Size())
+	merged.ForEach(func(j *job) {
+		ids = append(ids, j.id)
+	})
+	goassert.DeepEqual(t, []int{3, 0, 1, 4, 2, 5}, ids)
+}
+
+func Test_MergeSortedShouldMatchSortedElements_GivenRandomLists(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	lists := make([]list.ReadOnlyLister[int], 0)
+	all := make([]int, 0)
+	for i := 0; i < 20; i++ {
+		elements := make([]int, random.Intn(50))
+		for j := range elements {
+			elements[j] = random.Intn(100)
+		}
+		sort.Ints(elements)
+		all = append(all, elements...)
+		l := arraylist.New(elements...)
+		lists = append(lists, &l)
+	}
+	sort.Ints(all)
+
+	merged := MergeSorted[int](comparer.Natural[int]().Less(), lists...)
+
+	actual := make([]int, 0, merged.Size())
+	merged.ForEach(func(element *int) {
+		actual = append(actual, *element)
+	})
+	goassert.DeepEqual(t, all, actual)
+}
@@ -0,0 +1,168 @@
+package priorityqueue
+
+import (
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Sorts the first "size" elements of the given heap, which must satisfy the heap property, into priority order in
+place. Each top element is swapped behind the shrinking heap, which leaves the elements in reverse priority order,
+so they are reversed at the end
+*/
+func sortHeap[T any](heap []T, sequence []uint64, size int, arity int, compare func(*T, *T) bool) {
+	for last := size; last > 1; last-- {
+		swap(heap, sequence, 1, last)
+		siftDown(1, heap, sequence, last-1, arity, compare)
+	}
+
+	for i, j := 1, size; i < j; i, j = i+1, j-1 {
+		swap(heap, sequence, i, j)
+	}
+}
+
+/*
+Returns a new slice with the elements of the PriorityQueue in priority order, which is the order they would be
+dequeued in. The PriorityQueue is left untouched. Time complexity is O(n log n) and O(n) extra memory is used
+*/
+func (pq *PriorityQueue[T]) ToSortedSlice() []T {
+	if pq.container == nil || pq.size == 0 {
+		return []T{}
+	}
+
+	heap := make([]T, pq.size+1)
+	copy(heap, pq.container[:pq.size+1])
+
+	var sequence []uint64
+	if pq.sequence != nil {
+		sequence = make([]uint64, pq.size+1)
+		copy(sequence, pq.sequence[:pq.size+1])
+	}
+
+	sortHeap(heap, sequence, pq.size, pq.arity, pq.compare)
+
+	return heap[1:]
+}
+
+/*
+Removes all elements from the PriorityQueue and returns them in priority order. The elements are sorted in place
+in the internal array, which is handed over to the returned slice, so no extra memory is used. Time complexity is
+O(n log n)
+*/
+func (pq *PriorityQueue[T]) DrainSorted() []T {
+	if pq.container == nil || pq.size == 0 {
+		return []T{}
+	}
+
+	sortHeap(pq.container, pq.sequence, pq.size, pq.arity, pq.compare)
+	elements := pq.container[1 : pq.size+1]
+
+	var filler T
+	pq.container = []T{filler}
+	if pq.sequence != nil {
+		pq.sequence = make([]uint64, 1)
+	}
+	pq.cap = 0
+	pq.size = 0
+
+	return elements
+}
+
+/*
+Sorts the given slice in place so that its elements appear in priority order according to "compare", with the
+same meaning as in New: passing a less function sorts the slice in ascending order. The slice is copied into a
+temporary heap, so O(n) extra memory is used. Time complexity is O(n log n). The sort is not stable
+*/
+func HeapSort[T any](elements []T, compare func(*T, *T) bool) {
+	heap := make([]T, len(elements)+1)
+	copy(heap[1:], elements)
+
+	heapify(heap, nil, len(elements), defaultArity, compare)
+	sortHeap(heap, nil, len(elements), defaultArity, compare)
+
+	copy(elements, heap[1:])
+}
+
+/*
+Iterates through the elements of a PriorityQueue in priority order without modifying it. It keeps a small
+auxiliary heap of the positions which may hold the next element, so retrieving the first k elements takes
+O(k log k) time no matter how large the PriorityQueue is. The iterator must not be used after the PriorityQueue
+is modified.
+Usage:
+
+	it := pq.SortedIterator()
+	for it.Next() {
+		element := it.Value()
+	}
+*/
+type SortedIterator[T any] struct {
+	pq        *PriorityQueue[T]
+	positions []int
+	size      int
+	current   int
+}
+
+/*
+Returns a SortedIterator positioned before the element with the highest priority
+*/
+func (pq *PriorityQueue[T]) SortedIterator() *SortedIterator[T] {
+	it := &SortedIterator[T]{
+		pq:        pq,
+		positions: []int{0},
+	}
+	if pq.size > 0 {
+		it.push(1)
+	}
+
+	return it
+}
+
+/*
+Advances the iterator to the next element in priority order. Returns false if there are no more elements
+*/
+func (it *SortedIterator[T]) Next() bool {
+	if it.size == 0 {
+		it.current = 0
+		return false
+	}
+
+	it.current = it.positions[1]
+	swap(it.positions, nil, 1, it.size)
+	it.size--
+	siftDown(1, it.positions, nil, it.size, defaultArity, it.higher)
+
+	first := firstChildOf(it.current, it.pq.arity)
+	for child := first; child < first+it.pq.arity && child <= it.pq.size; child++ {
+		it.push(child)
+	}
+
+	return true
+}
+
+/*
+Returns a reference to the element the iterator is positioned at. The element must not be modified. Panics if
+Next was not called or returned false
+*/
+func (it *SortedIterator[T]) Value() *T {
+	if it.current == 0 {
+		panic(errors.Newf(
+			errors.ErrEmpty,
+			"SortedIterator.Value failed because Next was not called or returned false",
+		))
+	}
+
+	return &it.pq.container[it.current]
+}
+
+func (it *SortedIterator[T]) push(position int) {
+	it.size++
+	if it.size < len(it.positions) {
+		it.positions[it.size] = position
+	} else {
+		it.positions = append(it.positions, position)
+	}
+	siftUp(it.positions, nil, it.size, defaultArity, it.higher)
+}
+
+func (it *SortedIterator[T]) higher(a *int, b *int) bool {
+	return higher(it.pq.container, it.pq.sequence, *a, *b, it.pq.compare)
+}
@@ -0,0 +1,153 @@
+package priorityqueue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_ToSortedSliceShouldReturnElementsInPriorityOrder_WithoutModifyingPriorityQueue(t *testing.T) {
+	pq := Heapify([]int{14, 16, 5, 23, 7, 10, 1, 30}, lessInt)
+	heapOrder := append([]int(nil), pq.container...)
+
+	sorted := pq.ToSortedSlice()
+
+	goassert.DeepEqual(t, []int{1, 5, 7, 10, 14, 16, 23, 30}, sorted)
+	goassert.DeepEqual(t, heapOrder, pq.container)
+	goassert.Equal(t, 8, pq.Size())
+}
+
+func Test_ToSortedSliceShouldKeepFIFOOrder_GivenStablePriorityQueue(t *testing.T) {
+	pq := New(compareJobs, Stable(), Arity(4))
+	for id := 0; id < 12; id++ {
+		pq.Enqueue(job{priority: id % 2, id: id})
+	}
+
+	ids := make([]int, 0, 12)
+	for _, j := range pq.ToSortedSlice() {
+		ids = append(ids, j.id)
+	}
+
+	goassert.DeepEqual(t, []int{0, 2, 4, 6, 8, 10, 1, 3, 5, 7, 9, 11}, ids)
+	goassert.DeepEqual(t, ids, dequeueJobIds(&pq))
+}
+
+func Test_DrainSortedShouldReturnElementsInPriorityOrder_AndEmptyPriorityQueue(t *testing.T) {
+	pq := New(lessInt, Stable())
+	for _, element := range []int{14, 16, 5, 23, 7} {
+		pq.Enqueue(element)
+	}
+
+	sorted := pq.DrainSorted()
+
+	goassert.DeepEqual(t, []int{5, 7, 14, 16, 23}, sorted)
+	goassert.True(t, pq.Empty())
+
+	pq.Enqueue(3)
+	pq.Enqueue(1)
+
+	goassert.DeepEqual(t, []int{5, 7, 14, 16, 23}, sorted)
+	goassert.DeepEqual(t, []int{1, 3}, pq.DequeueN(2))
+}
+
+func Test_DrainSortedShouldReturnEmptySlice_GivenEmptyPriorityQueue(t *testing.T) {
+	pq := New(lessInt)
+
+	goassert.SliceLength(t, pq.DrainSorted(), 0)
+}
+
+func Test_ToSortedSliceAndDrainSortedShouldReturnEmptySlice_GivenZeroValuePriorityQueue(t *testing.T) {
+	var pq PriorityQueue[int]
+
+	goassert.DeepEqual(t, []int{}, pq.ToSortedSlice())
+	goassert.DeepEqual(t, []int{}, pq.DrainSorted())
+	goassert.Equal(t, 0, pq.Size())
+}
+
+func Test_HeapSortShouldSortSliceInPriorityOrder(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	elements := make([]int, 500)
+	for i := range elements {
+		elements[i] = random.Intn(100)
+	}
+	expected := append([]int(nil), elements...)
+	sort.Sort(sort.Reverse(sort.IntSlice(expected)))
+
+	HeapSort(elements, func(a *int, b *int) bool { return *a > *b })
+
+	goassert.DeepEqual(t, expected, elements)
+}
+
+func Test_HeapSortShouldHandleEmptyAndSingleElementSlices(t *testing.T) {
+	empty := []int{}
+	single := []int{5}
+
+	HeapSort(empty, lessInt)
+	HeapSort(single, lessInt)
+
+	goassert.SliceLength(t, empty, 0)
+	goassert.DeepEqual(t, []int{5}, single)
+}
+
+func Test_SortedIteratorShouldVisitElementsInPriorityOrder_WithoutModifyingPriorityQueue(t *testing.T) {
+	for _, arity := range []int{2, 3, 8} {
+		random := rand.New(rand.NewSource(int64(arity)))
+		pq := New(lessInt, Arity(arity))
+		for i := 0; i < 300; i++ {
+			pq.Enqueue(random.Intn(50))
+		}
+		expected := pq.ToSortedSlice()
+
+		visited := make([]int, 0, pq.Size())
+		it := pq.SortedIterator()
+		for it.Next() {
+			visited = append(visited, *it.Value())
+		}
+
+		goassert.DeepEqual(t, expected, visited)
+		goassert.DeepEqual(t, expected, pq.DequeueN(pq.Size()))
+	}
+}
+
+func Test_SortedIteratorShouldStopEarly(t *testing.T) {
+	pq := Heapify([]int{14, 16, 5, 23, 7, 10, 1, 30}, lessInt)
+
+	it := pq.SortedIterator()
+	first := make([]int, 0, 3)
+	for len(first) < 3 && it.Next() {
+		first = append(first, *it.Value())
+	}
+
+	goassert.DeepEqual(t, []int{1, 5, 7}, first)
+}
+
+func Test_SortedIteratorShouldNotVisitAnyElement_GivenZeroValuePriorityQueue(t *testing.T) {
+	var pq PriorityQueue[int]
+
+	it := pq.SortedIterator()
+
+	goassert.False(t, it.Next())
+}
+
+func Test_SortedIteratorValueShouldPanic_IfNextWasNotSuccessful(t *testing.T) {
+	pq := New(lessInt)
+	it := pq.SortedIterator()
+
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrEmpty,
+		"SortedIterator.Value failed because Next was not called or returned false",
+		func() { it.Value() },
+	)
+	goassert.False(t, it.Next())
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrEmpty,
+		"SortedIterator.Value failed because Next was not called or returned false",
+		func() { it.Value() },
+	)
+}
@@ -0,0 +1,63 @@
+package priorityqueue
+
+import (
+	"io"
+
+	"github.com/golanglibs/gocollections/codec"
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Streams the PriorityQueue to the given writer without building the whole payload in memory. Writes a
+versioned header (collection kind and element count) followed by one length-prefixed frame per element in
+internal heap order, where each frame is produced by the given element encoder. A stable PriorityQueue is
+streamed in priority order through a SortedIterator instead, so equal elements keep their order after decoding.
+Returns the number of bytes written
+*/
+func (pq *PriorityQueue[T]) EncodeTo(w io.Writer, encodeElement codec.ElementEncoder[T]) (int64, error) {
+	forEach := func(do func(*T)) {
+		for i := 1; i <= pq.size; i++ {
+			do(&pq.container[i])
+		}
+	}
+	if pq.sequence != nil {
+		forEach = func(do func(*T)) {
+			for it := pq.SortedIterator(); it.Next(); {
+				do(it.Value())
+			}
+		}
+	}
+
+	return codec.WriteStream(w, codec.KindPriorityQueue, pq.size, forEach, encodeElement)
+}
+
+/*
+Reads a stream written by PriorityQueue.EncodeTo from the given reader and replaces the elements of the
+PriorityQueue with the elements decoded by the given element decoder. The elements are heapified once the
+whole stream is read. If an error is returned, the PriorityQueue holds the elements decoded before the error.
+Returns an error wrapping errors.ErrNoComparator if the PriorityQueue has no compare function. Returns the number
+of bytes read
+*/
+func (pq *PriorityQueue[T]) DecodeFrom(r io.Reader, decodeElement codec.ElementDecoder[T]) (int64, error) {
+	if pq.compare == nil {
+		return 0, errors.Newf(
+			errors.ErrNoComparator,
+			"PriorityQueue.DecodeFrom failed because compare function is not set",
+		)
+	}
+
+	pq.size = 0
+	read, err := codec.ReadStream(r, codec.KindPriorityQueue, decodeElement, func(element T) {
+		pq.size++
+		if pq.cap < pq.size {
+			pq.container = append(pq.container, element)
+			pq.cap++
+		} else {
+			pq.container[pq.size] = element
+		}
+	})
+	pq.resequence()
+	heapify(pq.container, pq.sequence, pq.size, pq.arity, pq.compare)
+
+	return read, err
+}
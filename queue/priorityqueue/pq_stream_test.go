@@ -0,0 +1,48 @@
+package priorityqueue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func encodeMockStruct(w io.Writer, element *testhelpers.MockStruct) error {
+	return binary.Write(w, binary.LittleEndian, int64(element.Prop))
+}
+
+func decodeMockStruct(r io.Reader) (testhelpers.MockStruct, error) {
+	var prop int64
+	err := binary.Read(r, binary.LittleEndian, &prop)
+
+	return data(int(prop)), err
+}
+
+func Test_StreamRoundTripShouldPreservePriorityOrder(t *testing.T) {
+	original := Heapify([]testhelpers.MockStruct{{Prop: 14}, {Prop: 16}, {Prop: 5}, {Prop: 23}}, compare)
+	var buffer bytes.Buffer
+
+	written, err := original.EncodeTo(&buffer, encodeMockStruct)
+	goassert.Nil(t, err)
+
+	decoded := New(compare)
+	decoded.Enqueue(data(1))
+	read, err := decoded.DecodeFrom(&buffer, decodeMockStruct)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, written, read)
+	verifyPq(t, []testhelpers.MockStruct{{Prop: 5}, {Prop: 14}, {Prop: 16}, {Prop: 23}}, &decoded)
+}
+
+func Test_DecodeFromShouldReturnError_IfCompareIsNotSet(t *testing.T) {
+	var pq PriorityQueue[testhelpers.MockStruct]
+
+	_, err := pq.DecodeFrom(&bytes.Buffer{}, decodeMockStruct)
+
+	testhelpers.ErrorIs(t, errors.ErrNoComparator, err)
+	goassert.Equal(t, "PriorityQueue.DecodeFrom failed because compare function is not set", err.Error())
+}
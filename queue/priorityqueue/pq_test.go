@@ -1,9 +1,14 @@
 package priorityqueue
 
 import (
+	"bytes"
+	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/queue"
 	"github.com/golanglibs/gocollections/testhelpers"
@@ -38,6 +43,10 @@ func equals(s0 *testhelpers.MockStruct, s1 *testhelpers.MockStruct) bool {
 	return s0.Prop == s1.Prop
 }
 
+func hashData(s *testhelpers.MockStruct) uint64 {
+	return comparer.DefaultHash(&s.Prop)
+}
+
 func compare(s0 *testhelpers.MockStruct, s1 *testhelpers.MockStruct) bool {
 	return s0.Prop < s1.Prop
 }
@@ -181,7 +190,7 @@ func Test_DequeueShouldRemoveTheCorrectValue(t *testing.T) {
 
 func Test_DequeueShouldPanic_GivenEmptyPriorityQueue(t *testing.T) {
 	pq := New(compare)
-	goassert.PanicWithError(t, "Cannot Dequeue. PriorityQueue is empty", func() {
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot Dequeue. PriorityQueue is empty", func() {
 		pq.Dequeue()
 	})
 }
@@ -198,7 +207,7 @@ func Test_PeekShouldReturnCorrectValue_ButNotRemoveIt(t *testing.T) {
 
 func Test_PeekShouldPanic_GivenEmptyPriorityQueue(t *testing.T) {
 	pq := New(compare)
-	goassert.PanicWithError(t, "Cannot Peek. PriorityQueue is empty", func() {
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Cannot Peek. PriorityQueue is empty", func() {
 		pq.Peek()
 	})
 }
@@ -285,7 +294,7 @@ func Test_RemoveShouldReturnFalse_GivenEmptyPriorityQueue(t *testing.T) {
 
 func Test_RemoveShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
 	pq := New(compare)
-	goassert.PanicWithError(t, "Cannot Remove. Equality comparer was not set", func() {
+	testhelpers.PanicWithErrorIs(t, errors.ErrNoEqualityComparer, "Cannot Remove. Equality comparer was not set", func() {
 		pq.Remove(data(10))
 	})
 }
@@ -323,9 +332,12 @@ func Test_ContainsShouldReturnFalse_IfEmpty(t *testing.T) {
 
 func Test_ContainsShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
 	pq := New(compare)
-	goassert.PanicWithError(t, "Cannot execute Contains. Equality comparer was not set", func() {
-		pq.Contains(data(0))
-	})
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot execute Contains. Equality comparer was not set",
+		func() { pq.Contains(data(0)) },
+	)
 }
 
 func Test_ClearShouldResetPriorityQueue(t *testing.T) {
@@ -388,3 +400,371 @@ func Test_PriorityQueueShouldImplementCollectionerInterface(t *testing.T) {
 	pq := New(compare)
 	test_collectioner[testhelpers.MockStruct](&pq)
 }
+
+func Test_TryDequeueShouldRemoveAndReturnTopElement_GivenNonEmptyPriorityQueue(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(14), data(5), data(16)}, compare)
+
+	element, err := pq.TryDequeue()
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, data(5), element)
+	verifyPq(t, []testhelpers.MockStruct{data(14), data(16)}, &pq)
+}
+
+func Test_TryDequeueShouldReturnErrEmpty_GivenEmptyPriorityQueue(t *testing.T) {
+	pq := New(compare)
+
+	element, err := pq.TryDequeue()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	goassert.Equal(t, testhelpers.MockStruct{}, element)
+}
+
+func Test_TryPeekShouldReturnTopElement_GivenNonEmptyPriorityQueue(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(14), data(5), data(16)}, compare)
+
+	element, err := pq.TryPeek()
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, data(5), *element)
+	goassert.Equal(t, 3, pq.Size())
+}
+
+func Test_TryPeekShouldReturnErrEmpty_GivenEmptyPriorityQueue(t *testing.T) {
+	pq := New(compare)
+
+	element, err := pq.TryPeek()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	goassert.Nil(t, element)
+}
+
+func Test_TryRemoveShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(10)}, compare)
+
+	removed, err := pq.TryRemove(data(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	goassert.False(t, removed)
+	goassert.Equal(t, 1, pq.Size())
+}
+
+func Test_TryContainsShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(10)}, compare)
+
+	contains, err := pq.TryContains(data(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	goassert.False(t, contains)
+}
+
+func Test_TryContainsShouldReturnTrue_IfEqualityComparerIsSet_And_GivenElementExists(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(10), data(16)}, compare)
+	pq.SetEqualityComparer(equals)
+
+	contains, err := pq.TryContains(data(16))
+
+	goassert.Nil(t, err)
+	goassert.True(t, contains)
+}
+
+func Test_DequeueValueShouldRemoveAndReturnTopElement_GivenNonEmptyPriorityQueue(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(14), data(5), data(16)}, compare)
+
+	element, ok := pq.DequeueValue()
+
+	goassert.True(t, ok)
+	goassert.Equal(t, data(5), element)
+	verifyPq(t, []testhelpers.MockStruct{data(14), data(16)}, &pq)
+}
+
+func Test_DequeueValueShouldReturnFalse_GivenEmptyPriorityQueue(t *testing.T) {
+	pq := New(compare)
+
+	element, ok := pq.DequeueValue()
+
+	goassert.False(t, ok)
+	goassert.Equal(t, testhelpers.MockStruct{}, element)
+}
+
+func Test_DequeueNShouldRemoveAndReturnNElementsInPriorityOrder(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(14), data(5), data(16), data(1), data(10)}, compare)
+
+	elements := pq.DequeueN(3)
+
+	goassert.DeepEqual(t, []testhelpers.MockStruct{data(1), data(5), data(10)}, elements)
+	verifyPq(t, []testhelpers.MockStruct{data(14), data(16)}, &pq)
+}
+
+func Test_DequeueNShouldReturnAllElements_GivenNGreaterThanSize(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(14), data(5)}, compare)
+
+	elements := pq.DequeueN(5)
+
+	goassert.DeepEqual(t, []testhelpers.MockStruct{data(5), data(14)}, elements)
+	goassert.True(t, pq.Empty())
+}
+
+func Test_HeapifyShouldOrderElementsByComparator_GivenComparerAdapters(t *testing.T) {
+	ascending := Heapify([]int{5, 16, 10}, comparer.Natural[int]().Less())
+	descending := Heapify([]int{5, 16, 10}, comparer.Natural[int]().Greater())
+
+	goassert.DeepEqual(t, []int{5, 10, 16}, ascending.DequeueN(3))
+	goassert.DeepEqual(t, []int{16, 10, 5}, descending.DequeueN(3))
+}
+
+func Test_EqualsShouldReturnTrue_GivenPriorityQueueWithSameElementsInDifferentHeapOrder(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(14), data(5), data(16), data(5)}, compare)
+	pq.SetEqualityComparer(equals)
+	otherPq := New(compare)
+	for _, v := range []int{16, 5, 5, 14} {
+		otherPq.Enqueue(data(v))
+	}
+
+	goassert.True(t, pq.Equals(&otherPq))
+	goassert.Equal(t, pq.HashCode(hashData), otherPq.HashCode(hashData))
+}
+
+func Test_EqualsShouldReturnFalse_GivenPriorityQueueWithDifferentElements(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(14), data(5), data(5)}, compare)
+	pq.SetEqualityComparer(equals)
+	otherPq := Heapify([]testhelpers.MockStruct{data(14), data(14), data(5)}, compare)
+
+	goassert.False(t, pq.Equals(&otherPq))
+}
+
+func Test_EqualsShouldPanic_IfEqualityComparerIsNotSet(t *testing.T) {
+	pq := New(compare)
+	otherPq := New(compare)
+
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot execute Equals. Equality comparer was not set",
+		func() { pq.Equals(&otherPq) },
+	)
+}
+
+type job struct {
+	priority int
+	id       int
+}
+
+func compareJobs(a *job, b *job) bool {
+	return a.priority < b.priority
+}
+
+func dequeueJobIds(pq *PriorityQueue[job]) []int {
+	ids := make([]int, 0, pq.Size())
+	for !pq.Empty() {
+		next, _ := pq.TryDequeue()
+		ids = append(ids, next.id)
+	}
+
+	return ids
+}
+
+func Test_StablePriorityQueueShouldDequeueEqualPrioritiesInFIFOOrder(t *testing.T) {
+	pq := New(compareJobs, Stable())
+	for id := 0; id < 30; id++ {
+		pq.Enqueue(job{priority: id % 3, id: id})
+	}
+
+	expected := make([]int, 0, 30)
+	for priority := 0; priority < 3; priority++ {
+		for id := priority; id < 30; id += 3 {
+			expected = append(expected, id)
+		}
+	}
+
+	goassert.DeepEqual(t, expected, dequeueJobIds(&pq))
+}
+
+func Test_StablePriorityQueueShouldKeepFIFOOrder_GivenInterleavedEnqueueAndDequeue(t *testing.T) {
+	pq := New(compareJobs, Stable())
+	pq.Enqueue(job{priority: 1, id: 0})
+	pq.Enqueue(job{priority: 1, id: 1})
+	pq.Enqueue(job{priority: 0, id: 2})
+	pq.Dequeue()
+	pq.Enqueue(job{priority: 1, id: 3})
+	pq.Clear()
+	for id := 4; id < 10; id++ {
+		pq.Enqueue(job{priority: 1, id: id})
+	}
+	pq.Enqueue(job{priority: 0, id: 10})
+
+	goassert.DeepEqual(t, []int{10, 4, 5, 6, 7, 8, 9}, dequeueJobIds(&pq))
+}
+
+func Test_StableHeapifyShouldKeepOrderOfGivenSlice_GivenEqualPriorities(t *testing.T) {
+	jobs := make([]job, 0, 20)
+	for id := 0; id < 20; id++ {
+		jobs = append(jobs, job{priority: 1 - id%2, id: id})
+	}
+
+	pq := Heapify(jobs, compareJobs, Stable())
+
+	goassert.DeepEqual(
+		t,
+		[]int{1, 3, 5, 7, 9, 11, 13, 15, 17, 19, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18},
+		dequeueJobIds(&pq),
+	)
+}
+
+func Test_StablePriorityQueueShouldKeepFIFOOrder_AfterRemove(t *testing.T) {
+	pq := New(compareJobs, Stable())
+	pq.SetEqualityComparer(func(a *job, b *job) bool { return a.id == b.id })
+	for id := 0; id < 10; id++ {
+		pq.Enqueue(job{priority: 0, id: id})
+	}
+
+	pq.Remove(job{id: 4})
+
+	goassert.DeepEqual(t, []int{0, 1, 2, 3, 5, 6, 7, 8, 9}, dequeueJobIds(&pq))
+}
+
+func Test_StablePriorityQueueShouldMarshalSortedInFIFOOrder_GivenEqualPriorities(t *testing.T) {
+	pq := New(func(a *testhelpers.MockStruct, b *testhelpers.MockStruct) bool { return a.Prop/10 < b.Prop/10 }, Stable())
+	for _, prop := range []int{15, 3, 11, 7, 12, 1} {
+		pq.Enqueue(data(prop))
+	}
+	pq.SetMarshalSorted(true)
+
+	encoded, err := pq.MarshalJSON()
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, `[{"Prop":3},{"Prop":7},{"Prop":1},{"Prop":15},{"Prop":11},{"Prop":12}]`, string(encoded))
+}
+
+func Test_StablePriorityQueueShouldKeepFIFOOrder_AfterCodecRoundTrip(t *testing.T) {
+	byHundreds := func(a *testhelpers.MockStruct, b *testhelpers.MockStruct) bool { return a.Prop/100 < b.Prop/100 }
+	original := New(byHundreds, Stable())
+	for id := 0; id < 30; id++ {
+		original.Enqueue(data(id%3*100 + id))
+		if id%4 == 3 {
+			original.Dequeue()
+		}
+	}
+	expected := original.ToSortedSlice()
+
+	fromJSON := New(byHundreds, Stable())
+	encodedJSON, _ := original.MarshalJSON()
+	goassert.Nil(t, fromJSON.UnmarshalJSON(encodedJSON))
+
+	fromBinary := New(byHundreds, Stable())
+	encodedBinary, _ := original.MarshalBinary()
+	goassert.Nil(t, fromBinary.UnmarshalBinary(encodedBinary))
+
+	fromStream := New(byHundreds, Stable())
+	var buffer bytes.Buffer
+	_, err := original.EncodeTo(&buffer, encodeMockStruct)
+	goassert.Nil(t, err)
+	_, err = fromStream.DecodeFrom(&buffer, decodeMockStruct)
+	goassert.Nil(t, err)
+
+	goassert.DeepEqual(t, expected, fromJSON.DrainSorted())
+	goassert.DeepEqual(t, expected, fromBinary.DrainSorted())
+	goassert.DeepEqual(t, expected, fromStream.DrainSorted())
+}
+
+func Test_RemoveShouldRestoreOrder_GivenMovedElementBelongsAboveRemovedPosition(t *testing.T) {
+	pq := Heapify([]testhelpers.MockStruct{data(1), data(10), data(2), data(11), data(12), data(3), data(4)}, compare)
+	pq.SetEqualityComparer(equals)
+
+	pq.Remove(data(11))
+
+	verifyPq(t, []testhelpers.MockStruct{data(1), data(2), data(3), data(4), data(10), data(12)}, &pq)
+}
+
+func lessInt(a *int, b *int) bool {
+	return *a < *b
+}
+
+func Test_ArityShouldPanic_GivenArityLessThanTwo(t *testing.T) {
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrInvalidArgument,
+		"Arity of a PriorityQueue must be at least 2 but 1 was given",
+		func() { Arity(1) },
+	)
+}
+
+func Test_DAryHeapifyShouldOrderElements(t *testing.T) {
+	for _, arity := range []int{3, 4, 8} {
+		pq := Heapify(
+			[]testhelpers.MockStruct{data(14), data(16), data(5), data(23), data(7), data(10), data(1), data(30)},
+			compare,
+			Arity(arity),
+		)
+
+		goassert.Equal(t, arity, pq.arity)
+		verifyPq(
+			t,
+			[]testhelpers.MockStruct{data(1), data(5), data(7), data(10), data(14), data(16), data(23), data(30)},
+			&pq,
+		)
+	}
+}
+
+func Test_DAryPriorityQueueShouldMatchSortedModel_GivenRandomOperations(t *testing.T) {
+	for _, arity := range []int{2, 3, 4, 8} {
+		random := rand.New(rand.NewSource(int64(arity)))
+		pq := New(lessInt, Arity(arity))
+		pq.SetEqualityComparer(comparer.DefaultEquals[int])
+		model := make([]int, 0)
+
+		for i := 0; i < 3000; i++ {
+			switch random.Intn(4) {
+			case 0:
+				if len(model) > 0 {
+					sort.Ints(model)
+					dequeued, _ := pq.DequeueValue()
+					goassert.Equal(t, model[0], dequeued)
+					model = model[1:]
+				}
+			case 1:
+				if len(model) > 0 {
+					element := model[random.Intn(len(model))]
+					goassert.True(t, pq.Remove(element))
+					sort.Ints(model)
+					removed := sort.SearchInts(model, element)
+					model = append(model[:removed], model[removed+1:]...)
+				}
+			default:
+				element := random.Intn(500)
+				pq.Enqueue(element)
+				model = append(model, element)
+			}
+			goassert.Equal(t, len(model), pq.Size())
+		}
+
+		sort.Ints(model)
+		goassert.DeepEqual(t, model, pq.DequeueN(pq.Size()))
+	}
+}
+
+func Test_StableDAryPriorityQueueShouldDequeueEqualPrioritiesInFIFOOrder(t *testing.T) {
+	pq := New(compareJobs, Stable(), Arity(4))
+	for id := 0; id < 30; id++ {
+		pq.Enqueue(job{priority: id % 3, id: id})
+	}
+
+	expected := make([]int, 0, 30)
+	for priority := 0; priority < 3; priority++ {
+		for id := priority; id < 30; id += 3 {
+			expected = append(expected, id)
+		}
+	}
+
+	goassert.DeepEqual(t, expected, dequeueJobIds(&pq))
+}
+
+func Test_DAryPriorityQueueShouldKeepArity_WhenUnmarshalingJSON(t *testing.T) {
+	pq := New(lessInt, Arity(8))
+
+	err := pq.UnmarshalJSON([]byte(`[14, 16, 5, 23, 7, 10, 1, 30, 2, 9, 4]`))
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 8, pq.arity)
+	goassert.DeepEqual(t, []int{1, 2, 4, 5, 7, 9, 10, 14, 16, 23, 30}, pq.DequeueN(11))
+}
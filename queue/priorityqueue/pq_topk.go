@@ -0,0 +1,65 @@
+package priorityqueue
+
+import (
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/generic"
+)
+
+/*
+Returns the k elements of the given collection with the highest priorities in priority order, which are the
+first k elements a PriorityQueue created with the same "compare" function would dequeue. Keeps a bounded heap
+of the best k elements seen so far, which is built with Heapify once it is full, so the time complexity is
+O(n log k) and only O(k) extra memory is used. Returns all elements if the collection has fewer than k
+elements, and an empty slice if k is not positive. Elements with equal priorities are returned in an arbitrary
+order
+*/
+func TopK[T any](c generic.ReadOnlyCollectioner[T], k int, compare func(*T, *T) bool) []T {
+	if k <= 0 {
+		return []T{}
+	}
+
+	// the root of the bounded heap is the element with the lowest priority among the best k
+	lower := func(a *T, b *T) bool {
+		return compare(b, a)
+	}
+
+	var filler T
+	heap := []T{filler}
+	c.ForEach(func(element *T) {
+		size := len(heap) - 1
+		if size < k {
+			heap = append(heap, *element)
+			if size+1 == k {
+				heapify(heap, nil, k, defaultArity, lower)
+			}
+			return
+		}
+
+		if compare(element, &heap[1]) {
+			heap[1] = *element
+			siftDown(1, heap, nil, k, defaultArity, lower)
+		}
+	})
+
+	size := len(heap) - 1
+	if size < k {
+		heapify(heap, nil, size, defaultArity, lower)
+	}
+
+	elements := make([]T, size)
+	for last := size; last > 0; last-- {
+		elements[last-1] = heap[1]
+		swap(heap, nil, 1, last)
+		siftDown(1, heap, nil, last-1, defaultArity, lower)
+	}
+
+	return elements
+}
+
+/*
+Returns the k smallest elements of the given collection in ascending order. NaN values are ordered before every
+other floating point value. See TopK for the complexity and the handling of k
+*/
+func KSmallest[K comparer.Ordered](c generic.ReadOnlyCollectioner[K], k int) []K {
+	return TopK(c, k, comparer.Natural[K]().Less())
+}
@@ -0,0 +1,71 @@
+package priorityqueue
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/list/arraylist"
+	"github.com/golanglibs/gocollections/set/hashset"
+)
+
+func Test_TopKShouldReturnKElementsWithHighestPriorityInPriorityOrder(t *testing.T) {
+	l := arraylist.New(14, 16, 5, 23, 7, 10, 1, 30)
+
+	goassert.DeepEqual(t, []int{30, 23, 16}, TopK[int](&l, 3, comparer.Natural[int]().Greater()))
+	goassert.DeepEqual(t, []int{1, 5, 7, 10}, TopK[int](&l, 4, comparer.Natural[int]().Less()))
+}
+
+func Test_TopKShouldReturnAllElementsInPriorityOrder_GivenKGreaterThanSize(t *testing.T) {
+	s := hashset.New(14, 16, 5)
+
+	goassert.DeepEqual(t, []int{16, 14, 5}, TopK[int](&s, 10, comparer.Natural[int]().Greater()))
+}
+
+func Test_TopKShouldReturnEmptySlice_GivenNonPositiveK(t *testing.T) {
+	l := arraylist.New(14, 16, 5)
+
+	goassert.SliceLength(t, TopK[int](&l, 0, comparer.Natural[int]().Greater()), 0)
+	goassert.SliceLength(t, TopK[int](&l, -1, comparer.Natural[int]().Greater()), 0)
+}
+
+func Test_TopKShouldMatchSortedElements_GivenRandomElements(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	elements := make([]int, 1000)
+	for i := range elements {
+		elements[i] = random.Intn(200)
+	}
+	l := arraylist.New(elements...)
+
+	sorted := append([]int(nil), elements...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	for _, k := range []int{1, 2, 17, 999, 1000} {
+		goassert.DeepEqual(t, sorted[:k], TopK[int](&l, k, comparer.Natural[int]().Greater()))
+	}
+}
+
+func Test_TopKShouldRankElementsByGivenScore(t *testing.T) {
+	type player struct {
+		name  string
+		score int
+	}
+	l := arraylist.NewOfAny(player{"a", 10}, player{"b", 40}, player{"c", 25}, player{"d", 5})
+
+	top := TopK[player](&l, 2, comparer.ComparingBy(func(p *player) int { return p.score }).Greater())
+
+	goassert.DeepEqual(t, []player{{"b", 40}, {"c", 25}}, top)
+}
+
+func Test_KSmallestShouldReturnKSmallestElementsInAscendingOrder(t *testing.T) {
+	l := arraylist.New(14, 16, 5, 23, 7, 10, 1, 30)
+	words := arraylist.New("c", "b", "a")
+	floats := arraylist.New(2.5, math.NaN(), -1.0)
+
+	goassert.DeepEqual(t, []int{1, 5, 7}, KSmallest[int](&l, 3))
+	goassert.DeepEqual(t, []string{"a", "b"}, KSmallest[string](&words, 2))
+	goassert.True(t, math.IsNaN(KSmallest[float64](&floats, 1)[0]))
+}
@@ -0,0 +1,91 @@
+package priorityqueue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golanglibs/gocollections/errors"
+)
+
+/*
+Checks the internal state of the PriorityQueue: the size must fit the internal array, and no element may have a
+higher priority than its parent according to the "compare" function (and the insertion order in stable mode).
+An inconsistent "compare" function or elements changed through references returned by the PriorityQueue can
+break the heap property silently. Returns an error wrapping errors.ErrInvariantViolated describing the first
+violation found, or nil if the PriorityQueue is consistent. A zero value PriorityQueue, which has no internal
+array yet, is consistent. Meant to be used from tests and debug builds
+*/
+func (pq *PriorityQueue[T]) Validate() error {
+	if pq.container == nil && pq.size == 0 && pq.cap == 0 {
+		return nil
+	}
+
+	if pq.size < 0 || pq.size > pq.cap || pq.cap+1 != len(pq.container) {
+		return errors.Newf(
+			errors.ErrInvariantViolated,
+			"PriorityQueue.Validate found size %d and capacity %d which do not fit an internal array of length %d",
+			pq.size,
+			pq.cap,
+			len(pq.container),
+		)
+	}
+
+	if pq.sequence != nil && len(pq.sequence) < pq.size+1 {
+		return errors.Newf(
+			errors.ErrInvariantViolated,
+			"PriorityQueue.Validate found %d insertion sequence numbers for %d elements",
+			len(pq.sequence)-1,
+			pq.size,
+		)
+	}
+
+	for i := 2; i <= pq.size; i++ {
+		parent := parentOf(i, pq.arity)
+		if higher(pq.container, pq.sequence, i, parent, pq.compare) {
+			return errors.Newf(
+				errors.ErrInvariantViolated,
+				"PriorityQueue.Validate found the element at heap index %d with a higher priority than its parent "+
+					"at heap index %d",
+				i-1,
+				parent-1,
+			)
+		}
+	}
+
+	return nil
+}
+
+/*
+Returns a multi-line description of the internal heap for debugging. The first line holds the size and arity,
+followed by one line per element in heap order, indented by its depth in the heap. Each line shows the heap index
+of the element (the same 0-based index the errors of Validate report), the element formatted with %v and, in
+stable mode, its insertion sequence number. Elements with a higher priority than their parent are marked with
+"<- violates heap property". The PriorityQueue is left untouched
+*/
+func (pq *PriorityQueue[T]) Dump() string {
+	var dump strings.Builder
+	fmt.Fprintf(&dump, "PriorityQueue size=%d arity=%d stable=%t\n", pq.size, pq.arity, pq.sequence != nil)
+
+	var visit func(i int, depth int)
+	visit = func(i int, depth int) {
+		dump.WriteString(strings.Repeat("  ", depth))
+		fmt.Fprintf(&dump, "[%d] %v", i-1, pq.container[i])
+		if pq.sequence != nil {
+			fmt.Fprintf(&dump, " seq=%d", pq.sequence[i])
+		}
+		if i > 1 && higher(pq.container, pq.sequence, i, parentOf(i, pq.arity), pq.compare) {
+			dump.WriteString(" <- violates heap property")
+		}
+		dump.WriteString("\n")
+
+		first := firstChildOf(i, pq.arity)
+		for child := first; child < first+pq.arity && child <= pq.size; child++ {
+			visit(child, depth+1)
+		}
+	}
+	if pq.size > 0 {
+		visit(1, 0)
+	}
+
+	return dump.String()
+}
@@ -0,0 +1,103 @@
+package priorityqueue
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_ValidateShouldReturnNil_GivenConsistentPriorityQueue(t *testing.T) {
+	for _, opts := range [][]Option{nil, {Stable()}, {Arity(4)}} {
+		pq := Heapify([]int{14, 16, 5, 23, 7}, lessInt, opts...)
+		pq.Enqueue(1)
+		pq.Dequeue()
+		pq.Clear()
+		pq.Enqueue(3)
+
+		goassert.Nil(t, pq.Validate())
+	}
+}
+
+func Test_ValidateShouldReturnNil_GivenZeroValuePriorityQueue(t *testing.T) {
+	var pq PriorityQueue[int]
+
+	goassert.Nil(t, pq.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenElementsModifiedOutsideOfForEach(t *testing.T) {
+	pq := Heapify([]int{14, 16, 5, 23, 7}, lessInt)
+
+	*pq.Peek() = 100
+
+	err := pq.Validate()
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, err)
+	goassert.Equal(
+		t,
+		"PriorityQueue.Validate found the element at heap index 1 with a higher priority than its parent at heap index 0",
+		err.Error(),
+	)
+}
+
+func Test_ValidateShouldReturnError_GivenInconsistentCompare(t *testing.T) {
+	inconsistent := false
+	pq := New(func(a *int, b *int) bool {
+		if inconsistent {
+			return *a > *b
+		}
+		return *a < *b
+	})
+	for _, element := range []int{14, 16, 5, 23, 7} {
+		pq.Enqueue(element)
+	}
+
+	inconsistent = true
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, pq.Validate())
+}
+
+func Test_ValidateShouldReturnError_GivenSizeNotFittingInternalArray(t *testing.T) {
+	pq := Heapify([]int{14, 16, 5}, lessInt)
+	pq.size = 4
+
+	testhelpers.ErrorIs(t, errors.ErrInvariantViolated, pq.Validate())
+}
+
+func Test_DumpShouldDescribeHeapAsIndentedTree(t *testing.T) {
+	pq := Heapify([]int{14, 16, 5, 23, 7}, lessInt)
+
+	goassert.Equal(
+		t,
+		"PriorityQueue size=5 arity=2 stable=false\n"+
+			"[0] 5\n"+
+			"  [1] 7\n"+
+			"    [3] 23\n"+
+			"    [4] 16\n"+
+			"  [2] 14\n",
+		pq.Dump(),
+	)
+}
+
+func Test_DumpShouldShowSequenceNumbersAndMarkViolations(t *testing.T) {
+	pq := New(lessInt, Stable())
+	pq.Enqueue(5)
+	pq.Enqueue(7)
+
+	*pq.Peek() = 100
+
+	goassert.Equal(
+		t,
+		"PriorityQueue size=2 arity=2 stable=true\n"+
+			"[0] 100 seq=0\n"+
+			"  [1] 7 seq=1 <- violates heap property\n",
+		pq.Dump(),
+	)
+}
+
+func Test_DumpShouldOnlyDescribeHeader_GivenEmptyPriorityQueue(t *testing.T) {
+	pq := New(lessInt)
+
+	goassert.Equal(t, "PriorityQueue size=0 arity=2 stable=false\n", pq.Dump())
+}
@@ -1,33 +1,85 @@
 package queue
 
-type Queuer[T any] interface {
-	/* Sets the equality comparer to the given function */
-	SetEqualityComparer(equals func(*T, *T) bool)
-
+/*
+Read operations of queue-like collections. A ReadOnlyQueuer can be passed to callers which must not mutate the
+queue. Note that a Queuer also satisfies ReadOnlyQueuer
+*/
+type ReadOnlyQueuer[T any] interface {
 	/* Returns the size of the queue */
 	Size() int
 
 	/* Returns true if the queue is empty. Otherwise, false */
 	Empty() bool
 
-	/* Pushes the given value to the back of the queue */
-	Enqueue(element T)
-
-	/* Removes the element at the front of the queue. Panics if the queue is empty */
-	Dequeue()
-
 	/*
 		Returns a reference to the element at the front of the queue without removing it. Panics if the queue
 		is empty
 	*/
 	Peek() *T
 
+	/*
+		Returns a reference to the element at the front of the queue without removing it. Returns an error
+		wrapping errors.ErrEmpty instead of panicking if the queue is empty
+	*/
+	TryPeek() (*T, error)
+
 	/* Returns true if the given value is found in the queue. Otherwise, false */
 	Contains(element T) bool
 
-	/* Empties the queue. Operations performed depends on the implementation */
-	Clear()
+	/*
+		Returns true if the given value is found in the queue. Returns an error wrapping
+		errors.ErrNoEqualityComparer instead of panicking if the equality comparer is not set
+	*/
+	TryContains(element T) (bool, error)
+
+	/*
+		Returns true if the given queue has the same size and would dequeue equal elements in the same order,
+		as defined by queue.Equal, so the result does not depend on which queue Equals is called on. Panics if
+		the equality comparer is not set
+	*/
+	Equals(other ReadOnlyQueuer[T]) bool
+
+	/*
+		Returns a hash code of the elements in the queue which depends on the order they would be dequeued in,
+		as defined by queue.HashCode. Elements are hashed with the given function, which must return equal
+		hashes for elements the equality comparer considers equal
+	*/
+	HashCode(hash func(*T) uint64) uint64
 
 	/* Iterates through each element in the queue and executes the given function */
 	ForEach(do func(*T))
 }
+
+type Queuer[T any] interface {
+	ReadOnlyQueuer[T]
+
+	/* Sets the equality comparer to the given function */
+	SetEqualityComparer(equals func(*T, *T) bool)
+
+	/* Pushes the given value to the back of the queue */
+	Enqueue(element T)
+
+	/* Removes the element at the front of the queue. Panics if the queue is empty */
+	Dequeue()
+
+	/*
+		Removes the element at the front of the queue and returns it. Returns an error wrapping errors.ErrEmpty
+		instead of panicking if the queue is empty
+	*/
+	TryDequeue() (T, error)
+
+	/*
+		Removes the element at the front of the queue and returns it along with true. Returns the zero value and
+		false if the queue is empty
+	*/
+	DequeueValue() (T, bool)
+
+	/*
+		Removes up to n elements from the front of the queue and returns them in the order they were dequeued.
+		Returns fewer than n elements if the queue runs out of elements
+	*/
+	DequeueN(n int) []T
+
+	/* Empties the queue. Operations performed depends on the implementation */
+	Clear()
+}
@@ -12,7 +12,8 @@ A hash set that uses native go map under the hood. It implements Seter and Colle
 Set is not thread safe
 */
 type Set[K comparable] struct {
-	container map[K]interface{}
+	container    map[K]interface{}
+	marshalOrder func(*K, *K) bool
 }
 
 /*
@@ -116,7 +117,7 @@ func (s *Set[K]) Contains(element K) bool {
 Returns true when the given Set has the equal members as the current Set
 Implements Seter.Equals
 */
-func (s *Set[K]) Equals(set set.Seter[K]) bool {
+func (s *Set[K]) Equals(set set.ReadOnlySeter[K]) bool {
 	if s.Size() != set.Size() {
 		return false
 	}
@@ -130,11 +131,20 @@ func (s *Set[K]) Equals(set set.Seter[K]) bool {
 	return true
 }
 
+/*
+Returns a hash code of the members of the Set which does not depend on the iteration order. Members are hashed
+with the given function. Equal sets have equal hash codes.
+Implements Seter.HashCode
+*/
+func (s *Set[K]) HashCode(hash func(*K) uint64) uint64 {
+	return generic.UnorderedHashCode[K](s, hash)
+}
+
 /*
 Returns true when the given Set has common members with the current Set.
 Implements Seter.Intersects
 */
-func (s *Set[K]) Intersects(set set.Seter[K]) bool {
+func (s *Set[K]) Intersects(set set.ReadOnlySeter[K]) bool {
 	for k := range s.container {
 		if set.Contains(k) {
 			return true
@@ -148,7 +158,7 @@ func (s *Set[K]) Intersects(set set.Seter[K]) bool {
 Returns a new instance of Set with the common members between the current Set and the given Set.
 Implements Seter.GetIntersection
 */
-func (s *Set[K]) GetIntersection(set set.Seter[K]) set.Seter[K] {
+func (s *Set[K]) GetIntersection(set set.ReadOnlySeter[K]) set.Seter[K] {
 	intersection := &Set[K]{
 		container: make(map[K]interface{}),
 	}
@@ -166,7 +176,7 @@ func (s *Set[K]) GetIntersection(set set.Seter[K]) set.Seter[K] {
 Returns a new instance of Set with all the members of both the current Set and the given Set.
 Implements Seter.GetUnion
 */
-func (s *Set[K]) GetUnion(set set.Seter[K]) set.Seter[K] {
+func (s *Set[K]) GetUnion(set set.ReadOnlySeter[K]) set.Seter[K] {
 	union := &Set[K]{
 		container: make(map[K]interface{}),
 	}
@@ -182,11 +192,62 @@ func (s *Set[K]) GetUnion(set set.Seter[K]) set.Seter[K] {
 	return union
 }
 
+/*
+Returns a new instance of Set with the members of the current Set which are not members of the given Set.
+Implements Seter.GetDifference
+*/
+func (s *Set[K]) GetDifference(set set.ReadOnlySeter[K]) set.Seter[K] {
+	difference := &Set[K]{
+		container: make(map[K]interface{}),
+	}
+
+	for k := range s.container {
+		if !set.Contains(k) {
+			difference.Add(k)
+		}
+	}
+
+	return difference
+}
+
+/*
+Returns a new instance of Set with the members which are members of exactly one of the current Set and the
+given Set.
+Implements Seter.GetSymmetricDifference
+*/
+func (s *Set[K]) GetSymmetricDifference(set set.ReadOnlySeter[K]) set.Seter[K] {
+	difference := &Set[K]{
+		container: make(map[K]interface{}),
+	}
+
+	for k := range s.container {
+		if !set.Contains(k) {
+			difference.Add(k)
+		}
+	}
+
+	set.ForEach(func(member *K) {
+		if !s.Contains(*member) {
+			difference.Add(*member)
+		}
+	})
+
+	return difference
+}
+
+/*
+Returns true when the given Set has no common members with the current Set.
+Implements Seter.IsDisjoint
+*/
+func (s *Set[K]) IsDisjoint(set set.ReadOnlySeter[K]) bool {
+	return !s.Intersects(set)
+}
+
 /*
 Returns true if the current Set contains all the members of the given Set.
 Implements Seter.IsSupersetOf
 */
-func (s *Set[K]) IsSupersetOf(set set.Seter[K]) bool {
+func (s *Set[K]) IsSupersetOf(set set.ReadOnlySeter[K]) bool {
 	if s.Size() < set.Size() {
 		return false
 	}
@@ -201,11 +262,19 @@ func (s *Set[K]) IsSupersetOf(set set.Seter[K]) bool {
 	return isSuperset
 }
 
+/*
+Returns true if the current Set contains all the members of the given Set and at least one other member.
+Implements Seter.IsProperSupersetOf
+*/
+func (s *Set[K]) IsProperSupersetOf(set set.ReadOnlySeter[K]) bool {
+	return s.Size() > set.Size() && s.IsSupersetOf(set)
+}
+
 /*
 Returns true if the given Set has all the members of the current Set.
 Implements Seter.IsSubsetOf
 */
-func (s *Set[K]) IsSubsetOf(set set.Seter[K]) bool {
+func (s *Set[K]) IsSubsetOf(set set.ReadOnlySeter[K]) bool {
 	if s.Size() > set.Size() {
 		return false
 	}
@@ -219,6 +288,82 @@ func (s *Set[K]) IsSubsetOf(set set.Seter[K]) bool {
 	return true
 }
 
+/*
+Returns true if the given Set has all the members of the current Set and at least one other member.
+Implements Seter.IsProperSubsetOf
+*/
+func (s *Set[K]) IsProperSubsetOf(set set.ReadOnlySeter[K]) bool {
+	return s.Size() < set.Size() && s.IsSubsetOf(set)
+}
+
+/*
+Adds all the members of the given Set to the current Set
+*/
+func (s *Set[K]) UnionWith(set set.ReadOnlySeter[K]) {
+	set.ForEach(func(member *K) {
+		s.container[*member] = placeholder
+	})
+}
+
+/*
+Removes the members of the current Set which are not members of the given Set
+*/
+func (s *Set[K]) IntersectWith(set set.ReadOnlySeter[K]) {
+	for k := range s.container {
+		if !set.Contains(k) {
+			delete(s.container, k)
+		}
+	}
+}
+
+/*
+Removes the members of the given Set from the current Set. Iterates through whichever of the two sets is
+smaller
+*/
+func (s *Set[K]) ExceptWith(set set.ReadOnlySeter[K]) {
+	if s.isSameSet(set) {
+		s.Clear()
+		return
+	}
+
+	if s.Size() < set.Size() {
+		for k := range s.container {
+			if set.Contains(k) {
+				delete(s.container, k)
+			}
+		}
+		return
+	}
+
+	set.ForEach(func(member *K) {
+		delete(s.container, *member)
+	})
+}
+
+/*
+Keeps only the members which are members of exactly one of the current Set and the given Set: members of the
+given Set are removed from the current Set if present and added otherwise
+*/
+func (s *Set[K]) SymmetricExceptWith(set set.ReadOnlySeter[K]) {
+	if s.isSameSet(set) {
+		s.Clear()
+		return
+	}
+
+	set.ForEach(func(member *K) {
+		if _, exists := s.container[*member]; exists {
+			delete(s.container, *member)
+		} else {
+			s.container[*member] = placeholder
+		}
+	})
+}
+
+func (s *Set[K]) isSameSet(set set.ReadOnlySeter[K]) bool {
+	other, isSet := set.(*Set[K])
+	return isSet && other == s
+}
+
 /*
 Clears the current Set so it becomes empty. Under the hood, a new instance of builtin map is assigned as the
 new internal container
@@ -0,0 +1,68 @@
+package hashset
+
+import (
+	"sort"
+
+	"github.com/golanglibs/gocollections/set"
+)
+
+/*
+Creates a new instance of Set with all the members of the given sets and returns it. The sets are iterated
+from the smallest to the largest and the internal map is allocated with the size of the largest set.
+If no sets are given, an empty set is created
+*/
+func UnionAll[K comparable](sets ...set.ReadOnlySeter[K]) Set[K] {
+	sorted := sortedBySize(sets)
+
+	size := 0
+	if len(sorted) > 0 {
+		size = sorted[len(sorted)-1].Size()
+	}
+
+	union := Set[K]{
+		container: make(map[K]interface{}, size),
+	}
+	for _, s := range sorted {
+		union.UnionWith(s)
+	}
+
+	return union
+}
+
+/*
+Creates a new instance of Set with the members common to all the given sets and returns it. Only the smallest
+set is iterated and its members are looked up in the other sets from the smallest to the largest, so that
+members are discarded as early as possible.
+If no sets are given, an empty set is created
+*/
+func IntersectAll[K comparable](sets ...set.ReadOnlySeter[K]) Set[K] {
+	sorted := sortedBySize(sets)
+	if len(sorted) == 0 {
+		return New[K]()
+	}
+
+	intersection := Set[K]{
+		container: make(map[K]interface{}, sorted[0].Size()),
+	}
+	sorted[0].ForEach(func(member *K) {
+		for _, s := range sorted[1:] {
+			if !s.Contains(*member) {
+				return
+			}
+		}
+
+		intersection.container[*member] = placeholder
+	})
+
+	return intersection
+}
+
+func sortedBySize[K comparable](sets []set.ReadOnlySeter[K]) []set.ReadOnlySeter[K] {
+	sorted := make([]set.ReadOnlySeter[K], len(sets))
+	copy(sorted, sets)
+	sort.SliceStable(sorted, func(i int, j int) bool {
+		return sorted[i].Size() < sorted[j].Size()
+	})
+
+	return sorted
+}
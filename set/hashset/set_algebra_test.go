@@ -0,0 +1,143 @@
+package hashset
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_GetDifferenceShouldReturnMembersNotInGivenSet(t *testing.T) {
+	s := New(10, 16, 5, 14)
+	other := New(16, 14, 1)
+
+	difference := s.GetDifference(&other)
+
+	expected := New(10, 5)
+	goassert.True(t, difference.Equals(&expected))
+	goassert.Equal(t, 4, s.Size())
+}
+
+func Test_GetSymmetricDifferenceShouldReturnMembersInExactlyOneSet(t *testing.T) {
+	s := New(10, 16, 5, 14)
+	other := New(16, 14, 1)
+
+	difference := s.GetSymmetricDifference(&other)
+
+	expected := New(10, 5, 1)
+	goassert.True(t, difference.Equals(&expected))
+}
+
+func Test_IsDisjointShouldReturnTrue_OnlyIfSetsHaveNoCommonMembers(t *testing.T) {
+	s := New(10, 16)
+	disjoint := New(5, 14)
+	overlapping := New(16, 14)
+
+	goassert.True(t, s.IsDisjoint(&disjoint))
+	goassert.False(t, s.IsDisjoint(&overlapping))
+}
+
+func Test_IsProperSubsetOfShouldReturnFalse_GivenEqualSet(t *testing.T) {
+	s := New(10, 16)
+	equal := New(16, 10)
+	superset := New(10, 16, 5)
+
+	goassert.False(t, s.IsProperSubsetOf(&equal))
+	goassert.True(t, s.IsProperSubsetOf(&superset))
+	goassert.False(t, superset.IsProperSubsetOf(&s))
+}
+
+func Test_IsProperSupersetOfShouldReturnFalse_GivenEqualSet(t *testing.T) {
+	s := New(10, 16, 5)
+	equal := New(5, 16, 10)
+	subset := New(10, 16)
+
+	goassert.False(t, s.IsProperSupersetOf(&equal))
+	goassert.True(t, s.IsProperSupersetOf(&subset))
+	goassert.False(t, subset.IsProperSupersetOf(&s))
+}
+
+func Test_UnionWithShouldAddMembersOfGivenSet(t *testing.T) {
+	s := New(10, 16)
+	other := New(16, 5)
+
+	s.UnionWith(&other)
+
+	expected := New(10, 16, 5)
+	goassert.True(t, s.Equals(&expected))
+}
+
+func Test_IntersectWithShouldKeepOnlyCommonMembers(t *testing.T) {
+	s := New(10, 16, 5)
+	other := New(16, 5, 14)
+
+	s.IntersectWith(&other)
+
+	expected := New(16, 5)
+	goassert.True(t, s.Equals(&expected))
+}
+
+func Test_ExceptWithShouldRemoveMembersOfGivenSet(t *testing.T) {
+	small := New(10, 16)
+	large := New(16, 5, 14, 1)
+	s := New(10, 16, 5)
+
+	small.ExceptWith(&large)
+	s.ExceptWith(&small)
+
+	expectedSmall := New(10)
+	expected := New(16, 5)
+	goassert.True(t, small.Equals(&expectedSmall))
+	goassert.True(t, s.Equals(&expected))
+}
+
+func Test_ExceptWithAndSymmetricExceptWithShouldEmptySet_GivenSameSet(t *testing.T) {
+	s := New(10, 16, 5)
+	other := New(10, 16, 5)
+
+	s.ExceptWith(&s)
+	other.SymmetricExceptWith(&other)
+
+	goassert.True(t, s.Empty())
+	goassert.True(t, other.Empty())
+}
+
+func Test_SymmetricExceptWithShouldKeepMembersInExactlyOneSet(t *testing.T) {
+	s := New(10, 16, 5)
+	other := New(16, 14)
+
+	s.SymmetricExceptWith(&other)
+
+	expected := New(10, 5, 14)
+	goassert.True(t, s.Equals(&expected))
+}
+
+func Test_UnionAllShouldReturnAllMembersOfGivenSets(t *testing.T) {
+	s0 := New(10, 16)
+	s1 := New(16, 5, 14, 1)
+	s2 := New(2)
+
+	union := UnionAll[int](&s0, &s1, &s2)
+
+	expected := New(10, 16, 5, 14, 1, 2)
+	goassert.True(t, union.Equals(&expected))
+}
+
+func Test_IntersectAllShouldReturnMembersCommonToAllGivenSets(t *testing.T) {
+	s0 := New(10, 16, 5, 14)
+	s1 := New(16, 5, 14, 1)
+	s2 := New(5, 16)
+
+	intersection := IntersectAll[int](&s0, &s1, &s2)
+
+	expected := New(16, 5)
+	goassert.True(t, intersection.Equals(&expected))
+}
+
+func Test_UnionAllAndIntersectAllShouldReturnEmptySet_GivenNoSets(t *testing.T) {
+	union := UnionAll[int]()
+	intersection := IntersectAll[int]()
+
+	goassert.True(t, union.Empty())
+	goassert.True(t, intersection.Empty())
+	goassert.True(t, union.Add(10))
+}
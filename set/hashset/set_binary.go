@@ -0,0 +1,53 @@
+package hashset
+
+import "github.com/golanglibs/gocollections/codec"
+
+/*
+Encodes the Set into a binary payload made of a versioned header (collection kind and element count)
+followed by the gob encoding of its members in no particular order.
+Implements encoding.BinaryMarshaler
+*/
+func (s Set[K]) MarshalBinary() ([]byte, error) {
+	members := make([]K, 0, len(s.container))
+	for k := range s.container {
+		members = append(members, k)
+	}
+
+	return codec.MarshalElements(codec.KindHashSet, members)
+}
+
+/*
+Decodes a payload produced by Set.MarshalBinary and replaces the members of the Set with the decoded
+members. Returns an error if the payload is corrupted or was produced by a different kind of collection.
+Implements encoding.BinaryUnmarshaler
+*/
+func (s *Set[K]) UnmarshalBinary(data []byte) error {
+	members, err := codec.UnmarshalElements[K](codec.KindHashSet, data)
+	if err != nil {
+		return err
+	}
+
+	container := make(map[K]interface{}, len(members))
+	for _, member := range members {
+		container[member] = placeholder
+	}
+	s.container = container
+
+	return nil
+}
+
+/*
+Encodes the Set in the same format as Set.MarshalBinary.
+Implements gob.GobEncoder
+*/
+func (s Set[K]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+/*
+Decodes a payload produced by Set.GobEncode.
+Implements gob.GobDecoder
+*/
+func (s *Set[K]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
@@ -0,0 +1,45 @@
+package hashset
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/codec"
+)
+
+func Test_BinaryRoundTripShouldPreserveMembers(t *testing.T) {
+	original := New(10, 16, 14)
+
+	data, err := original.MarshalBinary()
+	goassert.Nil(t, err)
+
+	var decoded Set[int]
+	err = decoded.UnmarshalBinary(data)
+
+	goassert.Nil(t, err)
+	goassert.True(t, original.Equals(&decoded))
+}
+
+func Test_UnmarshalBinaryShouldReturnError_GivenCorruptedPayload(t *testing.T) {
+	set := New(3)
+
+	err := set.UnmarshalBinary([]byte{'G', 'C'})
+
+	goassert.True(t, errors.Is(err, codec.ErrInvalidHeader))
+	goassert.True(t, set.Contains(3))
+}
+
+func Test_GobShouldEncodeAndDecodeSet(t *testing.T) {
+	original := New("a", "b")
+
+	var buffer bytes.Buffer
+	goassert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded Set[string]
+	goassert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+
+	goassert.True(t, original.Equals(&decoded))
+}
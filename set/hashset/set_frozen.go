@@ -0,0 +1,155 @@
+package hashset
+
+import (
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/set"
+)
+
+/*
+Immutable hash set created by copying the members of a Set once. Since a FrozenSet never changes after its
+creation, it can be shared and read by any number of goroutines without synchronization.
+Implements ReadOnlySeter and ReadOnlyCollectioner
+*/
+type FrozenSet[K comparable] struct {
+	members Set[K]
+}
+
+/*
+Copies the members of the Set into an immutable FrozenSet, which can be shared across goroutines. Later
+changes to the Set are not reflected in the FrozenSet
+*/
+func (s *Set[K]) Freeze() FrozenSet[K] {
+	return FrozenSet[K]{
+		members: NewFromCollection[K](s),
+	}
+}
+
+/*
+Returns the number of members of the FrozenSet.
+Implements ReadOnlySeter.Size and ReadOnlyCollectioner.Size
+*/
+func (f *FrozenSet[K]) Size() int {
+	return f.members.Size()
+}
+
+/*
+Returns true if the FrozenSet is empty.
+Implements ReadOnlySeter.Empty and ReadOnlyCollectioner.Empty
+*/
+func (f *FrozenSet[K]) Empty() bool {
+	return f.members.Empty()
+}
+
+/*
+Returns true when the given element is a member of the FrozenSet.
+Implements ReadOnlySeter.Contains and ReadOnlyCollectioner.Contains
+*/
+func (f *FrozenSet[K]) Contains(element K) bool {
+	return f.members.Contains(element)
+}
+
+/*
+Returns true when the given set has the equal members as the FrozenSet.
+Implements ReadOnlySeter.Equals
+*/
+func (f *FrozenSet[K]) Equals(set set.ReadOnlySeter[K]) bool {
+	return f.members.Equals(set)
+}
+
+/*
+Returns a hash code of the members of the FrozenSet which does not depend on the iteration order.
+Implements ReadOnlySeter.HashCode
+*/
+func (f *FrozenSet[K]) HashCode(hash func(*K) uint64) uint64 {
+	return generic.UnorderedHashCode[K](f, hash)
+}
+
+/*
+Returns true when the given set has common members with the FrozenSet.
+Implements ReadOnlySeter.Intersects
+*/
+func (f *FrozenSet[K]) Intersects(set set.ReadOnlySeter[K]) bool {
+	return f.members.Intersects(set)
+}
+
+/*
+Returns a new instance of Set with the common members between the FrozenSet and the given set.
+Implements ReadOnlySeter.GetIntersection
+*/
+func (f *FrozenSet[K]) GetIntersection(set set.ReadOnlySeter[K]) set.Seter[K] {
+	return f.members.GetIntersection(set)
+}
+
+/*
+Returns a new instance of Set with all the members of both the FrozenSet and the given set.
+Implements ReadOnlySeter.GetUnion
+*/
+func (f *FrozenSet[K]) GetUnion(set set.ReadOnlySeter[K]) set.Seter[K] {
+	return f.members.GetUnion(set)
+}
+
+/*
+Returns a new instance of Set with the members of the FrozenSet which are not members of the given set.
+Implements ReadOnlySeter.GetDifference
+*/
+func (f *FrozenSet[K]) GetDifference(set set.ReadOnlySeter[K]) set.Seter[K] {
+	return f.members.GetDifference(set)
+}
+
+/*
+Returns a new instance of Set with the members which are members of exactly one of the FrozenSet and the
+given set.
+Implements ReadOnlySeter.GetSymmetricDifference
+*/
+func (f *FrozenSet[K]) GetSymmetricDifference(set set.ReadOnlySeter[K]) set.Seter[K] {
+	return f.members.GetSymmetricDifference(set)
+}
+
+/*
+Returns true when the given set has no common members with the FrozenSet.
+Implements ReadOnlySeter.IsDisjoint
+*/
+func (f *FrozenSet[K]) IsDisjoint(set set.ReadOnlySeter[K]) bool {
+	return f.members.IsDisjoint(set)
+}
+
+/*
+Returns true if the FrozenSet contains all the members of the given set.
+Implements ReadOnlySeter.IsSupersetOf
+*/
+func (f *FrozenSet[K]) IsSupersetOf(set set.ReadOnlySeter[K]) bool {
+	return f.members.IsSupersetOf(set)
+}
+
+/*
+Returns true if the FrozenSet contains all the members of the given set and at least one other member.
+Implements ReadOnlySeter.IsProperSupersetOf
+*/
+func (f *FrozenSet[K]) IsProperSupersetOf(set set.ReadOnlySeter[K]) bool {
+	return f.members.IsProperSupersetOf(set)
+}
+
+/*
+Returns true if the given set has all the members of the FrozenSet.
+Implements ReadOnlySeter.IsSubsetOf
+*/
+func (f *FrozenSet[K]) IsSubsetOf(set set.ReadOnlySeter[K]) bool {
+	return f.members.IsSubsetOf(set)
+}
+
+/*
+Returns true if the given set has all the members of the FrozenSet and at least one other member.
+Implements ReadOnlySeter.IsProperSubsetOf
+*/
+func (f *FrozenSet[K]) IsProperSubsetOf(set set.ReadOnlySeter[K]) bool {
+	return f.members.IsProperSubsetOf(set)
+}
+
+/*
+Iterates through each member of the FrozenSet and executes the given function on a reference to a copy of the
+member.
+Implements ReadOnlySeter.ForEach and ReadOnlyCollectioner.ForEach
+*/
+func (f *FrozenSet[K]) ForEach(do func(*K)) {
+	f.members.ForEach(do)
+}
@@ -0,0 +1,84 @@
+package hashset
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/set"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testReadOnlySeter[K comparable](s set.ReadOnlySeter[K]) {}
+
+func testReadOnlyCollectioner[K comparable](c generic.ReadOnlyCollectioner[K]) {}
+
+func Test_FrozenSetShouldImplementReadOnlySeterAndReadOnlyCollectioner(t *testing.T) {
+	s := New[int]()
+	frozen := s.Freeze()
+
+	testReadOnlySeter[int](&frozen)
+	testReadOnlyCollectioner[int](&frozen)
+}
+
+func Test_FreezeShouldCopyMembers_And_NotReflectLaterChanges(t *testing.T) {
+	s := New(10, 16, 5)
+
+	frozen := s.Freeze()
+	s.Add(14)
+	s.Remove(10)
+
+	expected := New(10, 16, 5)
+	goassert.Equal(t, 3, frozen.Size())
+	goassert.True(t, frozen.Contains(10))
+	goassert.False(t, frozen.Contains(14))
+	goassert.True(t, frozen.Equals(&expected))
+	goassert.True(t, expected.Equals(&frozen))
+}
+
+func Test_FrozenSetOperationsShouldReturnNewModifiableSets(t *testing.T) {
+	s := New(10, 16, 5)
+	frozen := s.Freeze()
+	other := New(16, 14)
+	subset := New(10, 16)
+
+	union := frozen.GetUnion(&other)
+	union.Add(1)
+
+	expected := New(10, 16, 5, 14, 1)
+	goassert.True(t, union.Equals(&expected))
+	goassert.Equal(t, 3, frozen.Size())
+	goassert.True(t, frozen.Intersects(&other))
+	goassert.True(t, frozen.IsProperSupersetOf(&subset))
+}
+
+func Test_UnmodifiableShouldReflectChanges_ButPanicOnModification(t *testing.T) {
+	s := New(10, 16)
+	unmodifiable := set.Unmodifiable[int](&s)
+	testSeter[int](&unmodifiable)
+
+	s.Add(5)
+
+	goassert.Equal(t, 3, unmodifiable.Size())
+	goassert.True(t, unmodifiable.Contains(5))
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"UnmodifiableSet.Add failed because the set is unmodifiable",
+		func() { unmodifiable.Add(1) },
+	)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"UnmodifiableSet.Remove failed because the set is unmodifiable",
+		func() { unmodifiable.Remove(10) },
+	)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"UnmodifiableSet.Clear failed because the set is unmodifiable",
+		func() { unmodifiable.Clear() },
+	)
+	goassert.Equal(t, 3, s.Size())
+}
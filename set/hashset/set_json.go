@@ -0,0 +1,55 @@
+package hashset
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+/*
+Sets the order in which the members of the Set are encoded by MarshalJSON. If "less(e0, e1)" returns true,
+"e0" appears before "e1" in the encoded array. Passing nil restores the default behavior of encoding the
+members in the unspecified iteration order of the internal map
+*/
+func (s *Set[K]) SetMarshalOrder(less func(*K, *K) bool) {
+	s.marshalOrder = less
+}
+
+/*
+Encodes the Set as a JSON array of its members. The members are sorted if an order was set through
+Set.SetMarshalOrder. Otherwise, they appear in no particular order.
+Implements json.Marshaler
+*/
+func (s Set[K]) MarshalJSON() ([]byte, error) {
+	members := make([]K, 0, len(s.container))
+	for k := range s.container {
+		members = append(members, k)
+	}
+
+	if s.marshalOrder != nil {
+		sort.Slice(members, func(i int, j int) bool {
+			return s.marshalOrder(&members[i], &members[j])
+		})
+	}
+
+	return json.Marshal(members)
+}
+
+/*
+Decodes the given JSON array and replaces the members of the Set with the decoded elements. Duplicate
+elements in the array are added only once.
+Implements json.Unmarshaler
+*/
+func (s *Set[K]) UnmarshalJSON(data []byte) error {
+	var members []K
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+
+	container := make(map[K]interface{}, len(members))
+	for _, member := range members {
+		container[member] = placeholder
+	}
+	s.container = container
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package hashset
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_MarshalJSONShouldEncodeAllMembers(t *testing.T) {
+	set := New(10, 16, 14)
+
+	data, err := json.Marshal(set)
+	goassert.Nil(t, err)
+
+	var members []int
+	goassert.Nil(t, json.Unmarshal(data, &members))
+	goassert.SimilarSlice(t, []int{10, 14, 16}, members)
+}
+
+func Test_MarshalJSONShouldEncodeSortedMembers_WhenMarshalOrderIsSet(t *testing.T) {
+	set := New(10, 16, 14, 3)
+	set.SetMarshalOrder(func(a *int, b *int) bool { return *a < *b })
+
+	data, err := json.Marshal(set)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[3,10,14,16]", string(data))
+}
+
+func Test_MarshalJSONShouldEncodeEmptyArray_GivenEmptySet(t *testing.T) {
+	set := New[string]()
+
+	data, err := json.Marshal(set)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[]", string(data))
+}
+
+func Test_UnmarshalJSONShouldReplaceMembers_AndIgnoreDuplicates(t *testing.T) {
+	set := New(3)
+
+	err := json.Unmarshal([]byte("[10,16,10,14]"), &set)
+
+	goassert.Nil(t, err)
+	goassert.MapLength(t, set.container, 3)
+	goassert.False(t, set.Contains(3))
+	goassert.True(t, set.Contains(16))
+}
+
+func Test_UnmarshalJSONShouldInitializeZeroValueSet(t *testing.T) {
+	var set Set[string]
+
+	err := json.Unmarshal([]byte(`["a","b"]`), &set)
+
+	goassert.Nil(t, err)
+	goassert.True(t, set.Add("c"))
+	goassert.Equal(t, 3, set.Size())
+}
+
+func Test_JSONRoundTripShouldPreserveMembers(t *testing.T) {
+	original := New("a", "b", "c")
+
+	data, err := json.Marshal(original)
+	goassert.Nil(t, err)
+
+	var decoded Set[string]
+	err = json.Unmarshal(data, &decoded)
+
+	goassert.Nil(t, err)
+	goassert.True(t, original.Equals(&decoded))
+}
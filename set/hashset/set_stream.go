@@ -0,0 +1,29 @@
+package hashset
+
+import (
+	"io"
+
+	"github.com/golanglibs/gocollections/codec"
+)
+
+/*
+Streams the Set to the given writer without building the whole payload in memory. Writes a versioned header
+(collection kind and element count) followed by one length-prefixed frame per member in no particular order,
+where each frame is produced by the given element encoder. Returns the number of bytes written
+*/
+func (s *Set[K]) EncodeTo(w io.Writer, encodeElement codec.ElementEncoder[K]) (int64, error) {
+	return codec.WriteStream(w, codec.KindHashSet, len(s.container), s.ForEach, encodeElement)
+}
+
+/*
+Reads a stream written by Set.EncodeTo from the given reader and replaces the members of the Set with the
+members decoded by the given element decoder. Members are added as they are decoded, so if an error is
+returned the Set holds the members decoded before the error. Returns the number of bytes read
+*/
+func (s *Set[K]) DecodeFrom(r io.Reader, decodeElement codec.ElementDecoder[K]) (int64, error) {
+	s.Clear()
+
+	return codec.ReadStream(r, codec.KindHashSet, decodeElement, func(member K) {
+		s.container[member] = placeholder
+	})
+}
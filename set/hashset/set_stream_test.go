@@ -0,0 +1,24 @@
+package hashset
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_StreamRoundTripShouldPreserveMembers(t *testing.T) {
+	original := New(10, 16, 14)
+	var buffer bytes.Buffer
+
+	written, err := original.EncodeTo(&buffer, testhelpers.EncodeInt)
+	goassert.Nil(t, err)
+
+	var decoded Set[int]
+	read, err := decoded.DecodeFrom(&buffer, testhelpers.DecodeInt)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, written, read)
+	goassert.True(t, original.Equals(&decoded))
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/set"
 	"github.com/golanglibs/gocollections/testhelpers"
@@ -246,3 +247,13 @@ func Test_HashSetShouldImplementCollectioner(t *testing.T) {
 	set := New[int]()
 	testCollectioner[int](&set)
 }
+
+func Test_HashCodeShouldBeEqual_GivenEqualSets(t *testing.T) {
+	s := New(10, 16, 5)
+	otherSet := New(5, 10, 16)
+	differentSet := New(5, 10, 14)
+
+	hash := comparer.DefaultHash[int]
+	goassert.Equal(t, s.HashCode(hash), otherSet.HashCode(hash))
+	goassert.NotEqual(t, s.HashCode(hash), differentSet.HashCode(hash))
+}
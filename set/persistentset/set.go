@@ -0,0 +1,273 @@
+package persistentset
+
+import (
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/maps/persistentmap"
+	"github.com/golanglibs/gocollections/set"
+	"github.com/golanglibs/gocollections/set/hashset"
+)
+
+/*
+Persistent set backed by a persistentmap.Map, a hash array mapped trie. A Set is immutable: Add and Remove
+return new versions in O(log32 n) time which share most of their structure with the Set they were created
+from. Two versions can be compared in time proportional to their differences through Equals and Diff.
+Since a Set never changes, it can be shared and read by any number of goroutines without synchronization.
+The zero value is an empty Set.
+Implements ReadOnlySeter and ReadOnlyCollectioner
+*/
+type Set[K comparable] struct {
+	members persistentmap.Map[K, struct{}]
+}
+
+/*
+Creates a new instance of Set with the given elements and returns it. Members are hashed with
+comparer.DefaultHash
+*/
+func New[K comparable](elements ...K) Set[K] {
+	return NewWithHash(nil, elements...)
+}
+
+/*
+Creates a new instance of Set with the given elements which hashes members with the given function and returns
+it. Members which are equal must have equal hashes. A nil function selects comparer.DefaultHash
+*/
+func NewWithHash[K comparable](hash func(*K) uint64, elements ...K) Set[K] {
+	s := Set[K]{members: persistentmap.NewWithHash[K, struct{}](hash)}
+	for _, element := range elements {
+		s = s.Add(element)
+	}
+
+	return s
+}
+
+/*
+Creates a new instance of Set with the elements of the given collection and returns it. Members are hashed
+with comparer.DefaultHash
+*/
+func NewFromCollection[K comparable](c generic.ReadOnlyCollectioner[K]) Set[K] {
+	s := New[K]()
+	c.ForEach(func(element *K) {
+		s = s.Add(*element)
+	})
+
+	return s
+}
+
+/*
+Returns a new version of the Set with the given element added. If the element is already a member, the Set
+itself is returned
+*/
+func (s Set[K]) Add(element K) Set[K] {
+	if s.members.ContainsKey(element) {
+		return s
+	}
+
+	return Set[K]{members: s.members.Put(element, struct{}{})}
+}
+
+/*
+Returns a new version of the Set without the given element. If the element is not a member, the Set itself is
+returned
+*/
+func (s Set[K]) Remove(element K) Set[K] {
+	return Set[K]{members: s.members.Remove(element)}
+}
+
+/*
+Returns the number of members of the Set.
+Implements ReadOnlySeter.Size and ReadOnlyCollectioner.Size
+*/
+func (s Set[K]) Size() int {
+	return s.members.Size()
+}
+
+/*
+Returns true if the Set is empty.
+Implements ReadOnlySeter.Empty and ReadOnlyCollectioner.Empty
+*/
+func (s Set[K]) Empty() bool {
+	return s.members.Empty()
+}
+
+/*
+Returns true when the given element is a member of the Set.
+Implements ReadOnlySeter.Contains and ReadOnlyCollectioner.Contains
+*/
+func (s Set[K]) Contains(element K) bool {
+	return s.members.ContainsKey(element)
+}
+
+/*
+Returns true when the given set has the same members as the Set. If the given set is also a Set, sub-trees
+shared by the two Sets are not compared, so comparing two versions derived from each other takes time
+proportional to their differences.
+Implements ReadOnlySeter.Equals
+*/
+func (s Set[K]) Equals(other set.ReadOnlySeter[K]) bool {
+	switch other := other.(type) {
+	case Set[K]:
+		return s.members.Equals(other.members, equalMembers)
+	case *Set[K]:
+		return s.members.Equals(other.members, equalMembers)
+	}
+
+	return s.Size() == other.Size() && s.IsSubsetOf(other)
+}
+
+func equalMembers(*struct{}, *struct{}) bool {
+	return true
+}
+
+/*
+Executes "added" on every member of "newer" which is not a member of the Set and "removed" on every member of
+the Set which is not a member of "newer". Sub-trees shared by the two Sets are skipped, so diffing two versions
+derived from each other takes time proportional to their differences
+*/
+func (s Set[K]) Diff(newer Set[K], added func(*K), removed func(*K)) {
+	s.members.Diff(newer.members, equalMembers, func(change *persistentmap.Change[K, struct{}]) {
+		if change.Old == nil {
+			added(&change.Key)
+		} else {
+			removed(&change.Key)
+		}
+	})
+}
+
+/*
+Returns a hash code of the members of the Set which does not depend on the iteration order. Members are hashed
+with the given function. Equal sets have equal hash codes.
+Implements ReadOnlySeter.HashCode
+*/
+func (s Set[K]) HashCode(hash func(*K) uint64) uint64 {
+	return generic.UnorderedHashCode[K](s, hash)
+}
+
+/*
+Returns true when the given set has common members with the Set.
+Implements ReadOnlySeter.Intersects
+*/
+func (s Set[K]) Intersects(other set.ReadOnlySeter[K]) bool {
+	intersects := false
+	s.ForEach(func(member *K) {
+		if !intersects && other.Contains(*member) {
+			intersects = true
+		}
+	})
+
+	return intersects
+}
+
+/*
+Returns a new instance of HashSet with the common members between the Set and the given set.
+Implements ReadOnlySeter.GetIntersection
+*/
+func (s Set[K]) GetIntersection(other set.ReadOnlySeter[K]) set.Seter[K] {
+	return s.materialize().GetIntersection(other)
+}
+
+/*
+Returns a new instance of HashSet with all the members of both the Set and the given set.
+Implements ReadOnlySeter.GetUnion
+*/
+func (s Set[K]) GetUnion(other set.ReadOnlySeter[K]) set.Seter[K] {
+	return s.materialize().GetUnion(other)
+}
+
+/*
+Returns a new instance of HashSet with the members of the Set which are not members of the given set.
+Implements ReadOnlySeter.GetDifference
+*/
+func (s Set[K]) GetDifference(other set.ReadOnlySeter[K]) set.Seter[K] {
+	return s.materialize().GetDifference(other)
+}
+
+/*
+Returns a new instance of HashSet with the members which are members of exactly one of the Set and the given
+set.
+Implements ReadOnlySeter.GetSymmetricDifference
+*/
+func (s Set[K]) GetSymmetricDifference(other set.ReadOnlySeter[K]) set.Seter[K] {
+	return s.materialize().GetSymmetricDifference(other)
+}
+
+/*
+Returns true when the given set has no common members with the Set.
+Implements ReadOnlySeter.IsDisjoint
+*/
+func (s Set[K]) IsDisjoint(other set.ReadOnlySeter[K]) bool {
+	return !s.Intersects(other)
+}
+
+/*
+Returns true if the Set contains all the members of the given set.
+Implements ReadOnlySeter.IsSupersetOf
+*/
+func (s Set[K]) IsSupersetOf(other set.ReadOnlySeter[K]) bool {
+	if s.Size() < other.Size() {
+		return false
+	}
+
+	isSuperset := true
+	other.ForEach(func(member *K) {
+		if isSuperset && !s.Contains(*member) {
+			isSuperset = false
+		}
+	})
+
+	return isSuperset
+}
+
+/*
+Returns true if the Set contains all the members of the given set and at least one other member.
+Implements ReadOnlySeter.IsProperSupersetOf
+*/
+func (s Set[K]) IsProperSupersetOf(other set.ReadOnlySeter[K]) bool {
+	return s.Size() > other.Size() && s.IsSupersetOf(other)
+}
+
+/*
+Returns true if the given set has all the members of the Set.
+Implements ReadOnlySeter.IsSubsetOf
+*/
+func (s Set[K]) IsSubsetOf(other set.ReadOnlySeter[K]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+
+	isSubset := true
+	s.ForEach(func(member *K) {
+		if isSubset && !other.Contains(*member) {
+			isSubset = false
+		}
+	})
+
+	return isSubset
+}
+
+/*
+Returns true if the given set has all the members of the Set and at least one other member.
+Implements ReadOnlySeter.IsProperSubsetOf
+*/
+func (s Set[K]) IsProperSubsetOf(other set.ReadOnlySeter[K]) bool {
+	return s.Size() < other.Size() && s.IsSubsetOf(other)
+}
+
+/*
+Iterates through each member of the Set and executes the given function on a reference to a copy of the
+member. The iteration order depends on the hashes of the members.
+Implements ReadOnlySeter.ForEach and ReadOnlyCollectioner.ForEach
+*/
+func (s Set[K]) ForEach(do func(*K)) {
+	s.members.ForEach(func(member *K, _ *struct{}) {
+		do(member)
+	})
+}
+
+func (s Set[K]) materialize() *hashset.Set[K] {
+	materialized := hashset.New[K]()
+	s.ForEach(func(member *K) {
+		materialized.Add(*member)
+	})
+
+	return &materialized
+}
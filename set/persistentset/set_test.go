@@ -0,0 +1,154 @@
+package persistentset
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/list/arraylist"
+	"github.com/golanglibs/gocollections/set"
+	"github.com/golanglibs/gocollections/set/hashset"
+)
+
+func testReadOnlySeter[K comparable](s set.ReadOnlySeter[K]) {}
+
+func testReadOnlyCollectioner[T any](c generic.ReadOnlyCollectioner[T]) {}
+
+func sortedMembers(s set.ReadOnlySeter[int]) []int {
+	members := make([]int, 0, s.Size())
+	s.ForEach(func(member *int) {
+		members = append(members, *member)
+	})
+	sort.Ints(members)
+
+	return members
+}
+
+func Test_SetShouldImplementReadOnlySeterAndReadOnlyCollectioner(t *testing.T) {
+	s := New[int]()
+
+	testReadOnlySeter[int](s)
+	testReadOnlyCollectioner[int](s)
+}
+
+func Test_New(t *testing.T) {
+	s := New(10, 16, 5, 10)
+
+	goassert.Equal(t, 3, s.Size())
+	goassert.False(t, s.Empty())
+	goassert.True(t, s.Contains(16))
+	goassert.False(t, s.Contains(1))
+	goassert.DeepEqual(t, []int{5, 10, 16}, sortedMembers(s))
+}
+
+func Test_NewWithHash(t *testing.T) {
+	s := NewWithHash(func(member *int) uint64 { return 0 }, 10, 16, 5)
+
+	goassert.Equal(t, 3, s.Size())
+	goassert.True(t, s.Contains(5))
+	goassert.DeepEqual(t, []int{10, 16}, sortedMembers(s.Remove(5)))
+}
+
+func Test_NewFromCollection(t *testing.T) {
+	l := arraylist.New(10, 16, 5, 16)
+
+	s := NewFromCollection[int](&l)
+
+	goassert.DeepEqual(t, []int{5, 10, 16}, sortedMembers(s))
+}
+
+func Test_ZeroValueSetShouldBeEmpty(t *testing.T) {
+	var s Set[int]
+
+	goassert.True(t, s.Empty())
+	goassert.False(t, s.Contains(1))
+	goassert.Equal(t, 1, s.Add(1).Size())
+}
+
+func Test_SetAddAndRemoveShouldReturnNewVersions_AndLeaveOldVersionsUntouched(t *testing.T) {
+	original := New(10, 16, 5)
+
+	added := original.Add(1)
+	removed := original.Remove(10)
+
+	goassert.DeepEqual(t, []int{5, 10, 16}, sortedMembers(original))
+	goassert.DeepEqual(t, []int{1, 5, 10, 16}, sortedMembers(added))
+	goassert.DeepEqual(t, []int{5, 16}, sortedMembers(removed))
+}
+
+func Test_SetEquals(t *testing.T) {
+	s := New(10, 16, 5)
+	h := hashset.New(10, 16, 5)
+
+	goassert.True(t, s.Equals(New(5, 16, 10)))
+	goassert.True(t, s.Equals(s.Add(1).Remove(1)))
+	goassert.False(t, s.Equals(s.Add(1)))
+	goassert.True(t, s.Equals(&h))
+	goassert.True(t, h.Equals(s))
+	goassert.False(t, s.Equals(s.Remove(10).Add(1)))
+}
+
+func Test_SetDiff(t *testing.T) {
+	old := New[int]()
+	for i := 0; i < 1000; i++ {
+		old = old.Add(i)
+	}
+	newer := old.Remove(10).Remove(20).Add(1000)
+
+	added := make([]int, 0)
+	removed := make([]int, 0)
+	old.Diff(newer, func(member *int) {
+		added = append(added, *member)
+	}, func(member *int) {
+		removed = append(removed, *member)
+	})
+	sort.Ints(removed)
+
+	goassert.DeepEqual(t, []int{1000}, added)
+	goassert.DeepEqual(t, []int{10, 20}, removed)
+}
+
+func Test_SetHashCodeShouldMatchHashSet(t *testing.T) {
+	s := New(10, 16, 5)
+	h := hashset.New(5, 10, 16)
+
+	goassert.Equal(t, h.HashCode(comparer.DefaultHash[int]), s.HashCode(comparer.DefaultHash[int]))
+}
+
+func Test_SetAlgebra(t *testing.T) {
+	s := New(1, 2, 3, 4)
+	other := hashset.New(3, 4, 5)
+
+	goassert.DeepEqual(t, []int{3, 4}, sortedMembers(s.GetIntersection(&other)))
+	goassert.DeepEqual(t, []int{1, 2, 3, 4, 5}, sortedMembers(s.GetUnion(&other)))
+	goassert.DeepEqual(t, []int{1, 2}, sortedMembers(s.GetDifference(&other)))
+	goassert.DeepEqual(t, []int{1, 2, 5}, sortedMembers(s.GetSymmetricDifference(&other)))
+	goassert.True(t, s.Intersects(&other))
+	goassert.False(t, s.IsDisjoint(&other))
+	goassert.True(t, s.IsDisjoint(New(7, 8)))
+}
+
+func Test_SetSubsetAndSuperset(t *testing.T) {
+	s := New(1, 2, 3)
+
+	goassert.True(t, s.IsSubsetOf(New(1, 2, 3)))
+	goassert.False(t, s.IsProperSubsetOf(New(1, 2, 3)))
+	goassert.True(t, s.IsProperSubsetOf(New(1, 2, 3, 4)))
+	goassert.False(t, s.IsSubsetOf(New(1, 2, 4)))
+	goassert.True(t, s.IsSupersetOf(New(1, 3)))
+	goassert.True(t, s.IsProperSupersetOf(New(1, 3)))
+	goassert.False(t, s.IsProperSupersetOf(New(1, 2, 3)))
+	goassert.False(t, s.IsSupersetOf(New(1, 4)))
+}
+
+func Test_SetForEachShouldIterateCopies(t *testing.T) {
+	s := New(10, 16, 5)
+
+	s.ForEach(func(member *int) {
+		*member = 0
+	})
+
+	goassert.DeepEqual(t, []int{5, 10, 16}, sortedMembers(s))
+}
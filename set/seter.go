@@ -1,42 +1,72 @@
 package set
 
-type Seter[K comparable] interface {
+/*
+Read operations of set-like collections. A ReadOnlySeter can be passed to callers which must not mutate the
+set. Note that a Seter also satisfies ReadOnlySeter, so wrap it with Unmodifiable or Freeze it to prevent
+callers from type-asserting it back
+*/
+type ReadOnlySeter[K comparable] interface {
 	/* Returns the size of the set */
 	Size() int
 
 	/* Returns true if the set is empty. Otherwise, false */
 	Empty() bool
 
-	/* Adds the given element to the Set. */
-	Add(element K) bool
-
-	/* Removes the given element from the Set. */
-	Remove(element K) bool
-
 	/* Returns true when the given element exists in the Set. */
 	Contains(element K) bool
 
 	/* Returns true when the given set has the equal members as the current set */
-	Equals(set Seter[K]) bool
+	Equals(set ReadOnlySeter[K]) bool
+
+	/*
+		Returns a hash code which does not depend on the iteration order of the members. Members are hashed with
+		the given function
+	*/
+	HashCode(hash func(*K) uint64) uint64
 
 	/* Returns true when the given set has common members with the current set. */
-	Intersects(set Seter[K]) bool
+	Intersects(set ReadOnlySeter[K]) bool
 
 	/* Returns a new instance of set with the common members between the current set and the given set. */
-	GetIntersection(set Seter[K]) Seter[K]
+	GetIntersection(set ReadOnlySeter[K]) Seter[K]
 
 	/* Returns a new instance of Set with all the members of both the current set and the given set. */
-	GetUnion(set Seter[K]) Seter[K]
+	GetUnion(set ReadOnlySeter[K]) Seter[K]
+
+	/* Returns a new instance of Set with the members of the current set which are not in the given set. */
+	GetDifference(set ReadOnlySeter[K]) Seter[K]
+
+	/* Returns a new instance of Set with the members which are in exactly one of the two sets. */
+	GetSymmetricDifference(set ReadOnlySeter[K]) Seter[K]
+
+	/* Returns true when the given set has no common members with the current set. */
+	IsDisjoint(set ReadOnlySeter[K]) bool
 
 	/* Returns true if the current set contains all the members of the given set. */
-	IsSupersetOf(set Seter[K]) bool
+	IsSupersetOf(set ReadOnlySeter[K]) bool
+
+	/* Returns true if the current set contains all the members of the given set and at least one more. */
+	IsProperSupersetOf(set ReadOnlySeter[K]) bool
 
 	/* Returns true if the given set has all the members of the current set. */
-	IsSubsetOf(set Seter[K]) bool
+	IsSubsetOf(set ReadOnlySeter[K]) bool
 
-	/* Empties the set. Operations performed depends on the implementation */
-	Clear()
+	/* Returns true if the given set has all the members of the current set and at least one more. */
+	IsProperSubsetOf(set ReadOnlySeter[K]) bool
 
 	/* Iterates through each element in the set and executes the given function */
 	ForEach(do func(*K))
 }
+
+type Seter[K comparable] interface {
+	ReadOnlySeter[K]
+
+	/* Adds the given element to the Set. */
+	Add(element K) bool
+
+	/* Removes the given element from the Set. */
+	Remove(element K) bool
+
+	/* Empties the set. Operations performed depends on the implementation */
+	Clear()
+}
@@ -0,0 +1,35 @@
+package setview
+
+import "github.com/golanglibs/gocollections/set"
+
+/*
+Read-only view of the difference of two sets. Members are computed on demand from the underlying sets, so the
+view reflects every later change made to them. Add, Remove and Clear panic with an error wrapping
+errors.ErrUnmodifiable.
+Implements Seter and Collectioner.
+DifferenceView is not thread safe
+*/
+type DifferenceView[K comparable] struct {
+	view[K]
+}
+
+/*
+Creates a view of the members of set "a" which are not members of set "b" and returns it
+*/
+func NewDifference[K comparable](a set.ReadOnlySeter[K], b set.ReadOnlySeter[K]) DifferenceView[K] {
+	return DifferenceView[K]{
+		view: view[K]{
+			name: "DifferenceView",
+			contains: func(element K) bool {
+				return a.Contains(element) && !b.Contains(element)
+			},
+			forEach: func(do func(*K)) {
+				a.ForEach(func(member *K) {
+					if !b.Contains(*member) {
+						do(member)
+					}
+				})
+			},
+		},
+	}
+}
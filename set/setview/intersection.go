@@ -0,0 +1,41 @@
+package setview
+
+import "github.com/golanglibs/gocollections/set"
+
+/*
+Read-only view of the intersection of two sets. Members are computed on demand from the underlying sets, so
+the view reflects every later change made to them. Add, Remove and Clear panic with an error wrapping
+errors.ErrUnmodifiable.
+Implements Seter and Collectioner.
+IntersectionView is not thread safe
+*/
+type IntersectionView[K comparable] struct {
+	view[K]
+}
+
+/*
+Creates a view of the members common to both of the given sets and returns it. Iterating the view goes
+through whichever of the two sets is smaller at the time of the iteration
+*/
+func NewIntersection[K comparable](a set.ReadOnlySeter[K], b set.ReadOnlySeter[K]) IntersectionView[K] {
+	return IntersectionView[K]{
+		view: view[K]{
+			name: "IntersectionView",
+			contains: func(element K) bool {
+				return a.Contains(element) && b.Contains(element)
+			},
+			forEach: func(do func(*K)) {
+				smaller, larger := a, b
+				if b.Size() < a.Size() {
+					smaller, larger = b, a
+				}
+
+				smaller.ForEach(func(member *K) {
+					if larger.Contains(*member) {
+						do(member)
+					}
+				})
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package setview
+
+import "github.com/golanglibs/gocollections/set"
+
+/*
+Read-only view of the union of two sets. Members are computed on demand from the underlying sets, so the view
+reflects every later change made to them. Add, Remove and Clear panic with an error wrapping
+errors.ErrUnmodifiable.
+Implements Seter and Collectioner.
+UnionView is not thread safe
+*/
+type UnionView[K comparable] struct {
+	view[K]
+}
+
+/*
+Creates a view of the members of either of the given sets and returns it
+*/
+func NewUnion[K comparable](a set.ReadOnlySeter[K], b set.ReadOnlySeter[K]) UnionView[K] {
+	return UnionView[K]{
+		view: view[K]{
+			name: "UnionView",
+			contains: func(element K) bool {
+				return a.Contains(element) || b.Contains(element)
+			},
+			forEach: func(do func(*K)) {
+				a.ForEach(do)
+				b.ForEach(func(member *K) {
+					if !a.Contains(*member) {
+						do(member)
+					}
+				})
+			},
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package setview
+
+import (
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/set"
+	"github.com/golanglibs/gocollections/set/hashset"
+)
+
+/*
+Read-only set whose members are computed on demand from other sets. It implements every read operation of
+Seter through the "contains" and "forEach" functions given by the concrete views
+*/
+type view[K comparable] struct {
+	name     string
+	contains func(element K) bool
+	forEach  func(do func(*K))
+}
+
+/*
+Returns the number of members of the view. The members are counted on every call, which takes linear time
+in the size of the underlying sets.
+Implements Seter.Size and Collectioner.Size
+*/
+func (v *view[K]) Size() int {
+	size := 0
+	v.forEach(func(*K) {
+		size++
+	})
+
+	return size
+}
+
+/*
+Returns true if the view has no members.
+Implements Seter.Empty and Collectioner.Empty
+*/
+func (v *view[K]) Empty() bool {
+	return v.Size() == 0
+}
+
+/*
+Panics because views are read-only. Add members to the underlying sets instead.
+Implements Seter.Add and Collectioner.Add
+*/
+func (v *view[K]) Add(element K) bool {
+	panic(errors.Newf(errors.ErrUnmodifiable, "%s.Add failed because set views are read-only", v.name))
+}
+
+/*
+Panics because views are read-only. Remove members from the underlying sets instead.
+Implements Seter.Remove and Collectioner.Remove
+*/
+func (v *view[K]) Remove(element K) bool {
+	panic(errors.Newf(errors.ErrUnmodifiable, "%s.Remove failed because set views are read-only", v.name))
+}
+
+/*
+Returns true when the given element is a member of the view.
+Implements Seter.Contains and Collectioner.Contains
+*/
+func (v *view[K]) Contains(element K) bool {
+	return v.contains(element)
+}
+
+/*
+Returns true when the given set has the equal members as the view.
+Implements Seter.Equals
+*/
+func (v *view[K]) Equals(set set.ReadOnlySeter[K]) bool {
+	return v.Size() == set.Size() && v.IsSubsetOf(set)
+}
+
+/*
+Returns a hash code of the members of the view which does not depend on the iteration order. Members are
+hashed with the given function. A view and a set with equal members have equal hash codes.
+Implements Seter.HashCode
+*/
+func (v *view[K]) HashCode(hash func(*K) uint64) uint64 {
+	return generic.UnorderedHashCode[K](v, hash)
+}
+
+/*
+Returns true when the given set has common members with the view.
+Implements Seter.Intersects
+*/
+func (v *view[K]) Intersects(set set.ReadOnlySeter[K]) bool {
+	intersects := false
+	v.forEach(func(member *K) {
+		if !intersects && set.Contains(*member) {
+			intersects = true
+		}
+	})
+
+	return intersects
+}
+
+/*
+Returns a new instance of HashSet with the common members between the view and the given set.
+Implements Seter.GetIntersection
+*/
+func (v *view[K]) GetIntersection(set set.ReadOnlySeter[K]) set.Seter[K] {
+	return v.materialize().GetIntersection(set)
+}
+
+/*
+Returns a new instance of HashSet with all the members of both the view and the given set.
+Implements Seter.GetUnion
+*/
+func (v *view[K]) GetUnion(set set.ReadOnlySeter[K]) set.Seter[K] {
+	return v.materialize().GetUnion(set)
+}
+
+/*
+Returns a new instance of HashSet with the members of the view which are not members of the given set.
+Implements Seter.GetDifference
+*/
+func (v *view[K]) GetDifference(set set.ReadOnlySeter[K]) set.Seter[K] {
+	return v.materialize().GetDifference(set)
+}
+
+/*
+Returns a new instance of HashSet with the members which are members of exactly one of the view and the given
+set.
+Implements Seter.GetSymmetricDifference
+*/
+func (v *view[K]) GetSymmetricDifference(set set.ReadOnlySeter[K]) set.Seter[K] {
+	return v.materialize().GetSymmetricDifference(set)
+}
+
+/*
+Returns true when the given set has no common members with the view.
+Implements Seter.IsDisjoint
+*/
+func (v *view[K]) IsDisjoint(set set.ReadOnlySeter[K]) bool {
+	return !v.Intersects(set)
+}
+
+/*
+Returns true if the view contains all the members of the given set.
+Implements Seter.IsSupersetOf
+*/
+func (v *view[K]) IsSupersetOf(set set.ReadOnlySeter[K]) bool {
+	isSuperset := true
+	set.ForEach(func(member *K) {
+		if isSuperset && !v.contains(*member) {
+			isSuperset = false
+		}
+	})
+
+	return isSuperset
+}
+
+/*
+Returns true if the view contains all the members of the given set and at least one other member.
+Implements Seter.IsProperSupersetOf
+*/
+func (v *view[K]) IsProperSupersetOf(set set.ReadOnlySeter[K]) bool {
+	return v.Size() > set.Size() && v.IsSupersetOf(set)
+}
+
+/*
+Returns true if the given set has all the members of the view.
+Implements Seter.IsSubsetOf
+*/
+func (v *view[K]) IsSubsetOf(set set.ReadOnlySeter[K]) bool {
+	isSubset := true
+	v.forEach(func(member *K) {
+		if isSubset && !set.Contains(*member) {
+			isSubset = false
+		}
+	})
+
+	return isSubset
+}
+
+/*
+Returns true if the given set has all the members of the view and at least one other member.
+Implements Seter.IsProperSubsetOf
+*/
+func (v *view[K]) IsProperSubsetOf(set set.ReadOnlySeter[K]) bool {
+	return v.Size() < set.Size() && v.IsSubsetOf(set)
+}
+
+/*
+Panics because views are read-only. Clear the underlying sets instead.
+Implements Seter.Clear
+*/
+func (v *view[K]) Clear() {
+	panic(errors.Newf(errors.ErrUnmodifiable, "%s.Clear failed because set views are read-only", v.name))
+}
+
+/*
+Iterates through each member of the view and executes the given "do" function on each member. Members are
+computed from the underlying sets during the iteration, so the underlying sets must not be modified by "do".
+Implements Seter.ForEach and Collectioner.ForEach
+*/
+func (v *view[K]) ForEach(do func(*K)) {
+	v.forEach(do)
+}
+
+/*
+Copies the current members of the view into a new HashSet
+*/
+func (v *view[K]) materialize() *hashset.Set[K] {
+	members := hashset.NewFromCollection[K](v)
+	return &members
+}
@@ -0,0 +1,165 @@
+package setview
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/set"
+	"github.com/golanglibs/gocollections/set/hashset"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testSeter[K comparable](s set.Seter[K]) {}
+
+func testCollectioner[K comparable](c generic.Collectioner[K]) {}
+
+func members[K comparable](s set.Seter[K]) []K {
+	result := []K{}
+	s.ForEach(func(member *K) {
+		result = append(result, *member)
+	})
+
+	return result
+}
+
+func Test_ViewsShouldImplementSeterAndCollectioner(t *testing.T) {
+	a := hashset.New[int]()
+	union := NewUnion[int](&a, &a)
+	intersection := NewIntersection[int](&a, &a)
+	difference := NewDifference[int](&a, &a)
+
+	testSeter[int](&union)
+	testSeter[int](&intersection)
+	testSeter[int](&difference)
+	testCollectioner[int](&union)
+	testCollectioner[int](&intersection)
+	testCollectioner[int](&difference)
+}
+
+func Test_UnionViewShouldContainMembersOfEitherSet(t *testing.T) {
+	a := hashset.New(10, 16, 5)
+	b := hashset.New(16, 14)
+
+	union := NewUnion[int](&a, &b)
+
+	goassert.Equal(t, 4, union.Size())
+	goassert.True(t, union.Contains(14))
+	goassert.True(t, union.Contains(10))
+	goassert.False(t, union.Contains(1))
+	goassert.SimilarSlice(t, []int{10, 16, 5, 14}, members[int](&union))
+}
+
+func Test_IntersectionViewShouldContainCommonMembers(t *testing.T) {
+	a := hashset.New(10, 16, 5)
+	b := hashset.New(16, 5, 14)
+
+	intersection := NewIntersection[int](&a, &b)
+
+	goassert.Equal(t, 2, intersection.Size())
+	goassert.True(t, intersection.Contains(16))
+	goassert.False(t, intersection.Contains(10))
+	goassert.SimilarSlice(t, []int{16, 5}, members[int](&intersection))
+}
+
+func Test_DifferenceViewShouldContainMembersOfFirstSetOnly(t *testing.T) {
+	a := hashset.New(10, 16, 5)
+	b := hashset.New(16, 14)
+
+	difference := NewDifference[int](&a, &b)
+
+	goassert.Equal(t, 2, difference.Size())
+	goassert.True(t, difference.Contains(10))
+	goassert.False(t, difference.Contains(16))
+	goassert.False(t, difference.Contains(14))
+	goassert.SimilarSlice(t, []int{10, 5}, members[int](&difference))
+}
+
+func Test_ViewsShouldReflectChangesToUnderlyingSets(t *testing.T) {
+	a := hashset.New(10, 16)
+	b := hashset.New(16)
+	union := NewUnion[int](&a, &b)
+	intersection := NewIntersection[int](&a, &b)
+	difference := NewDifference[int](&a, &b)
+
+	b.Add(10)
+	b.Add(1)
+
+	goassert.Equal(t, 3, union.Size())
+	goassert.True(t, union.Contains(1))
+	goassert.Equal(t, 2, intersection.Size())
+	goassert.True(t, intersection.Contains(10))
+	goassert.True(t, difference.Empty())
+}
+
+func Test_ViewsShouldBeComposable(t *testing.T) {
+	a := hashset.New(10, 16, 5)
+	b := hashset.New(16, 14)
+	c := hashset.New(5, 14)
+	union := NewUnion[int](&a, &b)
+
+	unionMinusC := NewDifference[int](&union, &c)
+
+	goassert.SimilarSlice(t, []int{10, 16}, members[int](&unionMinusC))
+}
+
+func Test_ViewShouldCompareWithSets(t *testing.T) {
+	a := hashset.New(10, 16, 5)
+	b := hashset.New(16, 14)
+	union := NewUnion[int](&a, &b)
+	equal := hashset.New(5, 10, 14, 16)
+	subset := hashset.New(10, 14)
+	disjoint := hashset.New(1, 2)
+
+	goassert.True(t, union.Equals(&equal))
+	goassert.True(t, equal.Equals(&union))
+	goassert.True(t, union.IsSupersetOf(&subset))
+	goassert.True(t, union.IsProperSupersetOf(&subset))
+	goassert.False(t, union.IsProperSubsetOf(&equal))
+	goassert.True(t, union.IsSubsetOf(&equal))
+	goassert.True(t, union.IsDisjoint(&disjoint))
+	goassert.True(t, union.Intersects(&subset))
+	goassert.Equal(t, equal.HashCode(comparer.DefaultHash[int]), union.HashCode(comparer.DefaultHash[int]))
+}
+
+func Test_GetOperationsShouldReturnSetsWhichDoNotReflectLaterChanges(t *testing.T) {
+	a := hashset.New(10, 16, 5)
+	b := hashset.New(16, 14)
+	union := NewUnion[int](&a, &b)
+	other := hashset.New(5, 1)
+
+	intersection := union.GetIntersection(&other)
+	difference := union.GetDifference(&other)
+	unionWithOther := union.GetUnion(&other)
+	a.Clear()
+
+	goassert.SimilarSlice(t, []int{5}, members(intersection))
+	goassert.SimilarSlice(t, []int{10, 16, 14}, members(difference))
+	goassert.SimilarSlice(t, []int{10, 16, 14, 5, 1}, members(unionWithOther))
+}
+
+func Test_ModifyingOperationsShouldPanic(t *testing.T) {
+	a := hashset.New(10)
+	union := NewUnion[int](&a, &a)
+
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"UnionView.Add failed because set views are read-only",
+		func() { union.Add(16) },
+	)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"UnionView.Remove failed because set views are read-only",
+		func() { union.Remove(10) },
+	)
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrUnmodifiable,
+		"UnionView.Clear failed because set views are read-only",
+		func() { union.Clear() },
+	)
+}
@@ -0,0 +1,189 @@
+package set
+
+import "github.com/golanglibs/gocollections/errors"
+
+/*
+Wrapper which gives read-only access to a Seter. Add, Remove and Clear panic with an error wrapping
+errors.ErrUnmodifiable. Members are handed out as references to copies, so callers cannot modify the wrapped
+set through them either.
+The wrapper does not copy the wrapped set: changes made through the original Seter are visible through the
+wrapper. Use Freeze on the set to get a snapshot instead.
+Implements Seter and Collectioner.
+UnmodifiableSet is as thread safe as the wrapped set
+*/
+type UnmodifiableSet[K comparable] struct {
+	set Seter[K]
+}
+
+/*
+Wraps the given Seter into an UnmodifiableSet and returns it
+*/
+func Unmodifiable[K comparable](set Seter[K]) UnmodifiableSet[K] {
+	return UnmodifiableSet[K]{
+		set: set,
+	}
+}
+
+func unmodifiableError(method string) error {
+	return errors.Newf(errors.ErrUnmodifiable, "UnmodifiableSet.%s failed because the set is unmodifiable", method)
+}
+
+/*
+Returns the number of members of the wrapped set.
+Implements Seter.Size and Collectioner.Size
+*/
+func (u *UnmodifiableSet[K]) Size() int {
+	return u.set.Size()
+}
+
+/*
+Returns true if the wrapped set is empty.
+Implements Seter.Empty and Collectioner.Empty
+*/
+func (u *UnmodifiableSet[K]) Empty() bool {
+	return u.set.Empty()
+}
+
+/*
+Panics because the set is unmodifiable.
+Implements Seter.Add and Collectioner.Add
+*/
+func (u *UnmodifiableSet[K]) Add(element K) bool {
+	panic(unmodifiableError("Add"))
+}
+
+/*
+Panics because the set is unmodifiable.
+Implements Seter.Remove and Collectioner.Remove
+*/
+func (u *UnmodifiableSet[K]) Remove(element K) bool {
+	panic(unmodifiableError("Remove"))
+}
+
+/*
+Returns true when the given element is a member of the wrapped set.
+Implements Seter.Contains and Collectioner.Contains
+*/
+func (u *UnmodifiableSet[K]) Contains(element K) bool {
+	return u.set.Contains(element)
+}
+
+/*
+Returns true when the given set has the equal members as the wrapped set.
+Implements Seter.Equals
+*/
+func (u *UnmodifiableSet[K]) Equals(set ReadOnlySeter[K]) bool {
+	return u.set.Equals(set)
+}
+
+/*
+Returns a hash code of the members of the wrapped set which does not depend on the iteration order.
+Implements Seter.HashCode
+*/
+func (u *UnmodifiableSet[K]) HashCode(hash func(*K) uint64) uint64 {
+	return u.set.HashCode(func(member *K) uint64 {
+		copied := *member
+		return hash(&copied)
+	})
+}
+
+/*
+Returns true when the given set has common members with the wrapped set.
+Implements Seter.Intersects
+*/
+func (u *UnmodifiableSet[K]) Intersects(set ReadOnlySeter[K]) bool {
+	return u.set.Intersects(set)
+}
+
+/*
+Returns a new, modifiable set with the common members between the wrapped set and the given set.
+Implements Seter.GetIntersection
+*/
+func (u *UnmodifiableSet[K]) GetIntersection(set ReadOnlySeter[K]) Seter[K] {
+	return u.set.GetIntersection(set)
+}
+
+/*
+Returns a new, modifiable set with all the members of both the wrapped set and the given set.
+Implements Seter.GetUnion
+*/
+func (u *UnmodifiableSet[K]) GetUnion(set ReadOnlySeter[K]) Seter[K] {
+	return u.set.GetUnion(set)
+}
+
+/*
+Returns a new, modifiable set with the members of the wrapped set which are not members of the given set.
+Implements Seter.GetDifference
+*/
+func (u *UnmodifiableSet[K]) GetDifference(set ReadOnlySeter[K]) Seter[K] {
+	return u.set.GetDifference(set)
+}
+
+/*
+Returns a new, modifiable set with the members which are members of exactly one of the wrapped set and the
+given set.
+Implements Seter.GetSymmetricDifference
+*/
+func (u *UnmodifiableSet[K]) GetSymmetricDifference(set ReadOnlySeter[K]) Seter[K] {
+	return u.set.GetSymmetricDifference(set)
+}
+
+/*
+Returns true when the given set has no common members with the wrapped set.
+Implements Seter.IsDisjoint
+*/
+func (u *UnmodifiableSet[K]) IsDisjoint(set ReadOnlySeter[K]) bool {
+	return u.set.IsDisjoint(set)
+}
+
+/*
+Returns true if the wrapped set contains all the members of the given set.
+Implements Seter.IsSupersetOf
+*/
+func (u *UnmodifiableSet[K]) IsSupersetOf(set ReadOnlySeter[K]) bool {
+	return u.set.IsSupersetOf(set)
+}
+
+/*
+Returns true if the wrapped set contains all the members of the given set and at least one other member.
+Implements Seter.IsProperSupersetOf
+*/
+func (u *UnmodifiableSet[K]) IsProperSupersetOf(set ReadOnlySeter[K]) bool {
+	return u.set.IsProperSupersetOf(set)
+}
+
+/*
+Returns true if the given set has all the members of the wrapped set.
+Implements Seter.IsSubsetOf
+*/
+func (u *UnmodifiableSet[K]) IsSubsetOf(set ReadOnlySeter[K]) bool {
+	return u.set.IsSubsetOf(set)
+}
+
+/*
+Returns true if the given set has all the members of the wrapped set and at least one other member.
+Implements Seter.IsProperSubsetOf
+*/
+func (u *UnmodifiableSet[K]) IsProperSubsetOf(set ReadOnlySeter[K]) bool {
+	return u.set.IsProperSubsetOf(set)
+}
+
+/*
+Panics because the set is unmodifiable.
+Implements Seter.Clear
+*/
+func (u *UnmodifiableSet[K]) Clear() {
+	panic(unmodifiableError("Clear"))
+}
+
+/*
+Iterates through each member of the wrapped set and executes the given function on a reference to a copy of
+the member.
+Implements Seter.ForEach and Collectioner.ForEach
+*/
+func (u *UnmodifiableSet[K]) ForEach(do func(*K)) {
+	u.set.ForEach(func(member *K) {
+		copied := *member
+		do(&copied)
+	})
+}
@@ -1,8 +1,10 @@
 package arraystack
 
 import (
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/list/arraylist"
+	"github.com/golanglibs/gocollections/stack"
 )
 
 /*
@@ -97,11 +99,47 @@ Removes the most recently pushed element in the stack. Panics if Stack is empty.
 Implements Stacker.Pop
 */
 func (s *Stack[T]) Pop() {
+	if _, err := s.TryPop(); err != nil {
+		panic(err)
+	}
+}
+
+/*
+Removes the most recently pushed element in the stack and returns it. Returns an error wrapping errors.ErrEmpty
+if Stack is empty.
+Implements Stacker.TryPop
+*/
+func (s *Stack[T]) TryPop() (T, error) {
 	if s.container.Empty() {
-		panic("Stack.Pop failed because stack is empty")
+		var zero T
+		return zero, errors.Newf(errors.ErrEmpty, "Stack.Pop failed because stack is empty")
 	}
 
+	element := *s.container.Back()
 	s.container.RemoveBack()
+
+	return element, nil
+}
+
+/*
+Removes the most recently pushed element in the stack and returns it along with true. Returns the zero value
+and false if Stack is empty.
+Implements Stacker.PopValue
+*/
+func (s *Stack[T]) PopValue() (T, bool) {
+	element, err := s.TryPop()
+
+	return element, err == nil
+}
+
+/*
+Removes up to n elements from the top of the stack and returns them in the order they were popped, so the most
+recently pushed element comes first. Returns fewer than n elements if Stack runs out of elements, and an empty
+slice if n is not positive.
+Implements Stacker.PopN
+*/
+func (s *Stack[T]) PopN(n int) []T {
+	return generic.TakeN(n, s.Size(), s.TryPop)
 }
 
 /*
@@ -109,11 +147,25 @@ Returns a reference to the most recently pushed element in the stack without rem
 empty. Implements Stacker.Peek
 */
 func (s *Stack[T]) Peek() *T {
+	element, err := s.TryPeek()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to the most recently pushed element in the stack without removing it. Returns an error
+wrapping errors.ErrEmpty if Stack is empty.
+Implements Stacker.TryPeek
+*/
+func (s *Stack[T]) TryPeek() (*T, error) {
 	if s.container.Empty() {
-		panic("Stack.Peek failed because stack is empty")
+		return nil, errors.Newf(errors.ErrEmpty, "Stack.Peek failed because stack is empty")
 	}
 
-	return s.container.Back()
+	return s.container.Back(), nil
 }
 
 /*
@@ -135,6 +187,14 @@ func (s *Stack[T]) Remove(element T) bool {
 	return s.container.Remove(element)
 }
 
+/*
+Removes the the given element and returns true if present in the Stack. Returns an error wrapping
+errors.ErrNoEqualityComparer if the equality comparer is not set
+*/
+func (s *Stack[T]) TryRemove(element T) (bool, error) {
+	return s.container.TryRemove(element)
+}
+
 /*
 Returns true if the given element exists in the Stack. Returns false otherwise.
 Implements Stacker.Contains and Collectioner.Contains
@@ -143,6 +203,33 @@ func (s *Stack[T]) Contains(element T) bool {
 	return s.container.Contains(element)
 }
 
+/*
+Returns true if the given element exists in the Stack. Returns an error wrapping errors.ErrNoEqualityComparer
+if the equality comparer is not set.
+Implements Stacker.TryContains
+*/
+func (s *Stack[T]) TryContains(element T) (bool, error) {
+	return s.container.TryContains(element)
+}
+
+/*
+Returns true if the given stack has the same size as the Stack and equal elements in the same order
+from bottom to top. Equality is determined by the equality comparer. Panics if the equality comparer is not set.
+Implements Stacker.Equals
+*/
+func (s *Stack[T]) Equals(other stack.ReadOnlyStacker[T]) bool {
+	return s.container.EqualsInOrder(other)
+}
+
+/*
+Returns a hash code which depends on the elements of the Stack and their order. Elements are hashed with the
+given function, which must return equal hashes for elements the equality comparer considers equal.
+Implements Stacker.HashCode
+*/
+func (s *Stack[T]) HashCode(hash func(*T) uint64) uint64 {
+	return s.container.HashCode(hash)
+}
+
 /*
 Empties the Stack.
 Implements Stacker.Clear and Collectioner.Clear
@@ -0,0 +1,54 @@
+package arraystack
+
+import "github.com/golanglibs/gocollections/codec"
+
+/*
+Encodes the Stack into a binary payload made of a versioned header (collection kind and element count)
+followed by the gob encoding of its elements from bottom to top.
+Implements encoding.BinaryMarshaler
+*/
+func (s Stack[T]) MarshalBinary() ([]byte, error) {
+	size := s.container.Size()
+	elements := make([]T, size)
+	for i := 0; i < size; i++ {
+		elements[i] = *s.container.At(i)
+	}
+
+	return codec.MarshalElements(codec.KindArrayStack, elements)
+}
+
+/*
+Decodes a payload produced by Stack.MarshalBinary and replaces the elements of the Stack with the decoded
+elements, where the last element becomes the top of the Stack. Returns an error if the payload is corrupted or
+was produced by a different kind of collection. The equality comparer of the Stack is left untouched.
+Implements encoding.BinaryUnmarshaler
+*/
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	elements, err := codec.UnmarshalElements[T](codec.KindArrayStack, data)
+	if err != nil {
+		return err
+	}
+
+	s.container.Clear()
+	for _, element := range elements {
+		s.container.Add(element)
+	}
+
+	return nil
+}
+
+/*
+Encodes the Stack in the same format as Stack.MarshalBinary.
+Implements gob.GobEncoder
+*/
+func (s Stack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+/*
+Decodes a payload produced by Stack.GobEncode.
+Implements gob.GobDecoder
+*/
+func (s *Stack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
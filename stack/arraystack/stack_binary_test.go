@@ -0,0 +1,49 @@
+package arraystack
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/codec"
+)
+
+func Test_BinaryRoundTripShouldPreserveOrderFromBottomToTop(t *testing.T) {
+	original := New(10, 16, 14)
+	original.Pop()
+
+	data, err := original.MarshalBinary()
+	goassert.Nil(t, err)
+
+	decoded := New(3, 5, 7)
+	err = decoded.UnmarshalBinary(data)
+
+	goassert.Nil(t, err)
+	verifyStack(t, []int{10, 16}, &decoded)
+	goassert.Equal(t, 16, *decoded.Peek())
+}
+
+func Test_UnmarshalBinaryShouldReturnErrKindMismatch_GivenPayloadOfOtherStack(t *testing.T) {
+	data, _ := codec.MarshalElements(codec.KindLinkedListStack, []int{10})
+	stack := New(3)
+
+	err := stack.UnmarshalBinary(data)
+
+	goassert.True(t, errors.Is(err, codec.ErrKindMismatch))
+	verifyStack(t, []int{3}, &stack)
+}
+
+func Test_GobShouldDecodeIntoZeroValueStack(t *testing.T) {
+	original := New(10, 16)
+
+	var buffer bytes.Buffer
+	goassert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded Stack[int]
+	goassert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+
+	verifyStack(t, []int{10, 16}, &decoded)
+	goassert.Equal(t, 16, *decoded.Peek())
+}
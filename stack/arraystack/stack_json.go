@@ -0,0 +1,19 @@
+package arraystack
+
+/*
+Encodes the Stack as a JSON array of its elements from bottom to top, so the last element of the array is the
+one that would be popped first.
+Implements json.Marshaler
+*/
+func (s Stack[T]) MarshalJSON() ([]byte, error) {
+	return s.container.MarshalJSON()
+}
+
+/*
+Decodes the given JSON array and replaces the elements of the Stack with the decoded elements, where the last
+element of the array becomes the top of the Stack. The equality comparer is left untouched.
+Implements json.Unmarshaler
+*/
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	return s.container.UnmarshalJSON(data)
+}
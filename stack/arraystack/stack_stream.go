@@ -0,0 +1,37 @@
+package arraystack
+
+import (
+	"io"
+
+	"github.com/golanglibs/gocollections/codec"
+)
+
+/*
+Streams the Stack to the given writer without building the whole payload in memory. Writes a versioned header
+(collection kind and element count) followed by one length-prefixed frame per element from bottom to top,
+where each frame is produced by the given element encoder. Returns the number of bytes written
+*/
+func (s *Stack[T]) EncodeTo(w io.Writer, encodeElement codec.ElementEncoder[T]) (int64, error) {
+	size := s.container.Size()
+	forEach := func(do func(*T)) {
+		for i := 0; i < size; i++ {
+			do(s.container.At(i))
+		}
+	}
+
+	return codec.WriteStream(w, codec.KindArrayStack, size, forEach, encodeElement)
+}
+
+/*
+Reads a stream written by Stack.EncodeTo from the given reader and replaces the elements of the Stack with the
+elements decoded by the given element decoder, where the last decoded element becomes the top. Elements are
+pushed as they are decoded, so if an error is returned the Stack holds the elements decoded before the error.
+The equality comparer is left untouched. Returns the number of bytes read
+*/
+func (s *Stack[T]) DecodeFrom(r io.Reader, decodeElement codec.ElementDecoder[T]) (int64, error) {
+	s.container.Clear()
+
+	return codec.ReadStream(r, codec.KindArrayStack, decodeElement, func(element T) {
+		s.container.Add(element)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
 	"github.com/golanglibs/gocollections/generic"
 	"github.com/golanglibs/gocollections/stack"
 	"github.com/golanglibs/gocollections/testhelpers"
@@ -130,7 +132,7 @@ func Test_PopShouldPanic_IfStackIsEmpty(t *testing.T) {
 	stack := New[int]()
 
 	expectedError := "Stack.Pop failed because stack is empty"
-	goassert.PanicWithError(t, expectedError, func() { stack.Pop() })
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, expectedError, func() { stack.Pop() })
 }
 
 func Test_PeekShouldReturnElementAtTopOfStack_IfStackIsNotEmpty(t *testing.T) {
@@ -148,7 +150,7 @@ func Test_PeekShouldPanic_IfStackIsEmpty(t *testing.T) {
 	stack := New[int]()
 
 	expectedError := "Stack.Peek failed because stack is empty"
-	goassert.PanicWithError(t, expectedError, func() { stack.Peek() })
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, expectedError, func() { stack.Peek() })
 }
 
 func Test_AddShouldReturnTrueAndAddGivenElementToTopOfStack(t *testing.T) {
@@ -229,3 +231,139 @@ func Test_LinkedListStackShouldImplementCollectioner(t *testing.T) {
 	stack := New[int]()
 	testCollectioner[int](&stack)
 }
+
+func Test_TryPopShouldRemoveAndReturnMostRecentlyPushedElement_IfStackIsNotEmpty(t *testing.T) {
+	stack := New(10, 16, 14)
+
+	element, err := stack.TryPop()
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 14, element)
+	goassert.Equal(t, 2, stack.Size())
+}
+
+func Test_TryPopShouldReturnErrEmpty_IfStackIsEmpty(t *testing.T) {
+	stack := New[int]()
+
+	element, err := stack.TryPop()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	goassert.Equal(t, 0, element)
+}
+
+func Test_TryPeekShouldReturnMostRecentlyPushedElement_IfStackIsNotEmpty(t *testing.T) {
+	stack := New(10, 16, 14)
+
+	element, err := stack.TryPeek()
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, 14, *element)
+	goassert.Equal(t, 3, stack.Size())
+}
+
+func Test_TryPeekShouldReturnErrEmpty_IfStackIsEmpty(t *testing.T) {
+	stack := New[int]()
+
+	element, err := stack.TryPeek()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, err)
+	goassert.Nil(t, element)
+}
+
+func Test_TryContainsShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	stack := NewOfAny(testhelpers.NewMockStruct(10))
+
+	contains, err := stack.TryContains(testhelpers.NewMockStruct(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	goassert.False(t, contains)
+}
+
+func Test_TryRemoveShouldReturnErrNoEqualityComparer_IfEqualityComparerIsNotSet(t *testing.T) {
+	stack := NewOfAny(testhelpers.NewMockStruct(10))
+
+	removed, err := stack.TryRemove(testhelpers.NewMockStruct(10))
+
+	testhelpers.ErrorIs(t, errors.ErrNoEqualityComparer, err)
+	goassert.False(t, removed)
+	goassert.Equal(t, 1, stack.Size())
+}
+
+func Test_PopValueShouldRemoveAndReturnMostRecentlyPushedElement_IfStackIsNotEmpty(t *testing.T) {
+	stack := New(10, 16, 14)
+
+	element, ok := stack.PopValue()
+
+	goassert.True(t, ok)
+	goassert.Equal(t, 14, element)
+	verifyStack(t, []int{10, 16}, &stack)
+}
+
+func Test_PopValueShouldReturnFalse_IfStackIsEmpty(t *testing.T) {
+	stack := New[int]()
+
+	element, ok := stack.PopValue()
+
+	goassert.False(t, ok)
+	goassert.Equal(t, 0, element)
+}
+
+func Test_PopNShouldRemoveAndReturnNMostRecentlyPushedElements(t *testing.T) {
+	stack := New(10, 16, 14, 5)
+
+	elements := stack.PopN(3)
+
+	goassert.DeepEqual(t, []int{5, 14, 16}, elements)
+	verifyStack(t, []int{10}, &stack)
+}
+
+func Test_PopNShouldReturnAllElements_GivenNGreaterThanSize(t *testing.T) {
+	stack := New(10, 16)
+
+	elements := stack.PopN(5)
+
+	goassert.DeepEqual(t, []int{16, 10}, elements)
+	goassert.True(t, stack.Empty())
+}
+
+func Test_PopNShouldReturnEmptySlice_GivenNonPositiveN(t *testing.T) {
+	stack := New(10, 16)
+
+	goassert.Equal(t, 0, len(stack.PopN(0)))
+	goassert.Equal(t, 0, len(stack.PopN(-1)))
+	verifyStack(t, []int{10, 16}, &stack)
+}
+
+func Test_EqualsShouldReturnTrue_GivenStackWithEqualElementsInSameOrder(t *testing.T) {
+	stack := New(10, 16, 14)
+	otherStack := New(10, 16, 14)
+
+	goassert.True(t, stack.Equals(&otherStack))
+}
+
+func Test_EqualsShouldReturnFalse_GivenStackWithDifferentOrderOrSize(t *testing.T) {
+	stack := New(10, 16, 14)
+	reordered := New(14, 16, 10)
+	shorter := New(10, 16)
+
+	goassert.False(t, stack.Equals(&reordered))
+	goassert.False(t, stack.Equals(&shorter))
+}
+
+func Test_EqualsShouldIgnorePoppedElements(t *testing.T) {
+	stack := New(10, 16, 14)
+	stack.Pop()
+	otherStack := New(10, 16)
+
+	goassert.True(t, stack.Equals(&otherStack))
+}
+
+func Test_HashCodeShouldBeEqual_GivenEqualStacks(t *testing.T) {
+	stack := New(10, 16, 14)
+	otherStack := New(10, 16, 14)
+	reordered := New(14, 16, 10)
+
+	hash := comparer.DefaultHash[int]
+	goassert.Equal(t, stack.HashCode(hash), otherStack.HashCode(hash))
+	goassert.NotEqual(t, stack.HashCode(hash), reordered.HashCode(hash))
+}
@@ -0,0 +1,54 @@
+package linkedliststack
+
+import "github.com/golanglibs/gocollections/codec"
+
+/*
+Encodes the LinkedListStack into a binary payload made of a versioned header (collection kind and element
+count) followed by the gob encoding of its elements from bottom to top.
+Implements encoding.BinaryMarshaler
+*/
+func (s LinkedListStack[T]) MarshalBinary() ([]byte, error) {
+	elements := make([]T, 0, s.container.Size())
+	s.container.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return codec.MarshalElements(codec.KindLinkedListStack, elements)
+}
+
+/*
+Decodes a payload produced by LinkedListStack.MarshalBinary and replaces the elements of the LinkedListStack
+with the decoded elements, where the last element becomes the top of the LinkedListStack. Returns an error if
+the payload is corrupted or was produced by a different kind of collection. The equality comparer of the
+LinkedListStack is left untouched.
+Implements encoding.BinaryUnmarshaler
+*/
+func (s *LinkedListStack[T]) UnmarshalBinary(data []byte) error {
+	elements, err := codec.UnmarshalElements[T](codec.KindLinkedListStack, data)
+	if err != nil {
+		return err
+	}
+
+	s.container.Clear()
+	for _, element := range elements {
+		s.container.Add(element)
+	}
+
+	return nil
+}
+
+/*
+Encodes the LinkedListStack in the same format as LinkedListStack.MarshalBinary.
+Implements gob.GobEncoder
+*/
+func (s LinkedListStack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+/*
+Decodes a payload produced by LinkedListStack.GobEncode.
+Implements gob.GobDecoder
+*/
+func (s *LinkedListStack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
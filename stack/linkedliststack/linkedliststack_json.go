@@ -0,0 +1,19 @@
+package linkedliststack
+
+/*
+Encodes the LinkedListStack as a JSON array of its elements from bottom to top, so the last element of the
+array is the one that would be popped first.
+Implements json.Marshaler
+*/
+func (s LinkedListStack[T]) MarshalJSON() ([]byte, error) {
+	return s.container.MarshalJSON()
+}
+
+/*
+Decodes the given JSON array and replaces the elements of the LinkedListStack with the decoded elements, where
+the last element of the array becomes the top of the LinkedListStack. The equality comparer is left untouched.
+Implements json.Unmarshaler
+*/
+func (s *LinkedListStack[T]) UnmarshalJSON(data []byte) error {
+	return s.container.UnmarshalJSON(data)
+}
@@ -0,0 +1,44 @@
+package linkedliststack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_MarshalJSONShouldEncodeElementsFromBottomToTop(t *testing.T) {
+	stack := New[int]()
+	stack.Push(10)
+	stack.Push(16)
+	stack.Push(14)
+
+	data, err := json.Marshal(stack)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, "[10,16,14]", string(data))
+}
+
+func Test_UnmarshalJSONShouldReplaceElements_WithLastElementAtTop(t *testing.T) {
+	stack := New(3)
+
+	err := json.Unmarshal([]byte("[10,16,14]"), &stack)
+
+	goassert.Nil(t, err)
+	verifyStack(t, []int{10, 16, 14}, &stack)
+	goassert.Equal(t, 14, *stack.Peek())
+}
+
+func Test_JSONRoundTripShouldPreserveOrder(t *testing.T) {
+	original := New(10, 16, 14)
+
+	data, err := json.Marshal(original)
+	goassert.Nil(t, err)
+
+	decoded := New[int]()
+	err = json.Unmarshal(data, &decoded)
+
+	goassert.Nil(t, err)
+	verifyStack(t, []int{10, 16, 14}, &decoded)
+	goassert.True(t, decoded.Contains(16))
+}
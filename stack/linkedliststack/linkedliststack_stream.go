@@ -0,0 +1,30 @@
+package linkedliststack
+
+import (
+	"io"
+
+	"github.com/golanglibs/gocollections/codec"
+)
+
+/*
+Streams the LinkedListStack to the given writer without building the whole payload in memory. Writes a
+versioned header (collection kind and element count) followed by one length-prefixed frame per element from
+bottom to top, where each frame is produced by the given element encoder. Returns the number of bytes written
+*/
+func (s *LinkedListStack[T]) EncodeTo(w io.Writer, encodeElement codec.ElementEncoder[T]) (int64, error) {
+	return codec.WriteStream(w, codec.KindLinkedListStack, s.container.Size(), s.container.ForEach, encodeElement)
+}
+
+/*
+Reads a stream written by LinkedListStack.EncodeTo from the given reader and replaces the elements of the
+LinkedListStack with the elements decoded by the given element decoder, where the last decoded element becomes
+the top. Elements are pushed as they are decoded, so if an error is returned the LinkedListStack holds the
+elements decoded before the error. The equality comparer is left untouched. Returns the number of bytes read
+*/
+func (s *LinkedListStack[T]) DecodeFrom(r io.Reader, decodeElement codec.ElementDecoder[T]) (int64, error) {
+	s.container.Clear()
+
+	return codec.ReadStream(r, codec.KindLinkedListStack, decodeElement, func(element T) {
+		s.container.Add(element)
+	})
+}
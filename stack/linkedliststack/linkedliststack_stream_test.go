@@ -0,0 +1,26 @@
+package linkedliststack
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func Test_StreamRoundTripShouldPreserveOrderFromBottomToTop(t *testing.T) {
+	original := New(10, 16, 14)
+	original.Pop()
+	var buffer bytes.Buffer
+
+	written, err := original.EncodeTo(&buffer, testhelpers.EncodeInt)
+	goassert.Nil(t, err)
+
+	var decoded LinkedListStack[int]
+	read, err := decoded.DecodeFrom(&buffer, testhelpers.DecodeInt)
+
+	goassert.Nil(t, err)
+	goassert.Equal(t, written, read)
+	verifyStack(t, []int{10, 16}, &decoded)
+	goassert.Equal(t, 16, *decoded.Peek())
+}
@@ -0,0 +1,233 @@
+package persistentstack
+
+import (
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/stack"
+)
+
+/*
+Persistent stack implemented as a singly linked list of immutable cells. Last element to be pushed will be
+popped first (LIFO). A Stack is immutable: Push and Pop return new stacks in O(1) time which share all the
+cells below their top with the Stack they were created from, which makes it a good fit for undo histories.
+Since a Stack never changes, it can be shared and read by any number of goroutines without synchronization.
+Elements are handed out as references to copies. The zero value is an empty Stack with nil equality comparer.
+Implements ReadOnlyStacker and ReadOnlyCollectioner
+*/
+type Stack[T any] struct {
+	equals func(*T, *T) bool
+	top    *cell[T]
+	size   int
+}
+
+type cell[T any] struct {
+	value T
+	next  *cell[T]
+}
+
+/*
+Creates a new instance of Stack with the given elements pushed in order with a default equality comparer and
+returns it. The last given element is the top of the stack.
+If no elements are given, then an empty stack is created. Elements must be comparable
+*/
+func New[K comparable](elements ...K) Stack[K] {
+	return NewOfAny(elements...).WithEqualityComparer(comparer.DefaultEquals[K])
+}
+
+/*
+Creates a new instance of Stack with the given elements pushed in order with nil equality comparer and returns
+it. The last given element is the top of the stack.
+If no elements are given, then an empty stack is created. Elements can be of any type
+*/
+func NewOfAny[T any](elements ...T) Stack[T] {
+	var s Stack[T]
+	for _, element := range elements {
+		s = s.Push(element)
+	}
+
+	return s
+}
+
+/*
+Returns a copy of the Stack which uses the given equality comparer. The elements are shared
+*/
+func (s Stack[T]) WithEqualityComparer(equals func(*T, *T) bool) Stack[T] {
+	s.equals = equals
+	return s
+}
+
+/*
+Returns the number of elements in the Stack.
+Implements ReadOnlyStacker.Size and ReadOnlyCollectioner.Size
+*/
+func (s Stack[T]) Size() int {
+	return s.size
+}
+
+/*
+Returns true if the Stack is empty.
+Implements ReadOnlyStacker.Empty and ReadOnlyCollectioner.Empty
+*/
+func (s Stack[T]) Empty() bool {
+	return s.size == 0
+}
+
+/*
+Returns a new Stack with the given element pushed on top of the elements of the Stack
+*/
+func (s Stack[T]) Push(element T) Stack[T] {
+	return Stack[T]{
+		equals: s.equals,
+		top:    &cell[T]{value: element, next: s.top},
+		size:   s.size + 1,
+	}
+}
+
+/*
+Returns a new Stack without the recently pushed element. Panics if the Stack is empty
+*/
+func (s Stack[T]) Pop() Stack[T] {
+	popped, err := s.TryPop()
+	if err != nil {
+		panic(err)
+	}
+
+	return popped
+}
+
+/*
+Returns a new Stack without the recently pushed element. Returns an error wrapping errors.ErrEmpty if the Stack
+is empty
+*/
+func (s Stack[T]) TryPop() (Stack[T], error) {
+	if s.top == nil {
+		return s, errors.Newf(errors.ErrEmpty, "Stack.Pop failed because stack is empty")
+	}
+
+	return Stack[T]{equals: s.equals, top: s.top.next, size: s.size - 1}, nil
+}
+
+/*
+Returns a reference to a copy of the recently pushed element. Panics if the Stack is empty.
+Implements ReadOnlyStacker.Peek
+*/
+func (s Stack[T]) Peek() *T {
+	element, err := s.TryPeek()
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
+/*
+Returns a reference to a copy of the recently pushed element. Returns an error wrapping errors.ErrEmpty if the
+Stack is empty.
+Implements ReadOnlyStacker.TryPeek
+*/
+func (s Stack[T]) TryPeek() (*T, error) {
+	if s.top == nil {
+		return nil, errors.Newf(errors.ErrEmpty, "Stack.Peek failed because stack is empty")
+	}
+
+	element := s.top.value
+	return &element, nil
+}
+
+/*
+Returns true if the given element exists in the Stack. Panics if the equality comparer is not set.
+Implements ReadOnlyStacker.Contains and ReadOnlyCollectioner.Contains
+*/
+func (s Stack[T]) Contains(element T) bool {
+	contains, err := s.TryContains(element)
+	if err != nil {
+		panic(err)
+	}
+
+	return contains
+}
+
+/*
+Returns true if the given element exists in the Stack. Returns an error wrapping errors.ErrNoEqualityComparer
+if the equality comparer is not set.
+Implements ReadOnlyStacker.TryContains
+*/
+func (s Stack[T]) TryContains(element T) (bool, error) {
+	if s.equals == nil {
+		return false, errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		)
+	}
+
+	for c := s.top; c != nil; c = c.next {
+		if s.equals(&c.value, &element) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+/*
+Returns true if the given stack has the same size as the Stack and equal elements in the same order from bottom
+to top. Two Stacks sharing their cells are compared without walking the shared cells. Panics if the equality
+comparer is not set.
+Implements ReadOnlyStacker.Equals
+*/
+func (s Stack[T]) Equals(other stack.ReadOnlyStacker[T]) bool {
+	if s.equals == nil {
+		panic(errors.Newf(
+			errors.ErrNoEqualityComparer,
+			"Cannot compute equality of elements since equality comparer is not set",
+		))
+	}
+
+	if persistent, isStack := other.(Stack[T]); isStack {
+		return s.equalsStack(persistent)
+	}
+
+	return generic.SequenceEqual[T](s, other, s.equals)
+}
+
+func (s Stack[T]) equalsStack(other Stack[T]) bool {
+	if s.size != other.size {
+		return false
+	}
+
+	for a, b := s.top, other.top; a != b; a, b = a.next, b.next {
+		if !s.equals(&a.value, &b.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Returns a hash code which depends on the elements of the Stack and their order from bottom to top.
+Implements ReadOnlyStacker.HashCode
+*/
+func (s Stack[T]) HashCode(hash func(*T) uint64) uint64 {
+	return generic.OrderedHashCode[T](s, hash)
+}
+
+/*
+Iterates through each element of the Stack from bottom to top and executes the given function on a reference to
+a copy of the element. Note that the order of iteration is the opposite of the order each element would be
+popped.
+Implements ReadOnlyStacker.ForEach and ReadOnlyCollectioner.ForEach
+*/
+func (s Stack[T]) ForEach(do func(*T)) {
+	elements := make([]T, s.size)
+	i := s.size
+	for c := s.top; c != nil; c = c.next {
+		i--
+		elements[i] = c.value
+	}
+
+	for i := range elements {
+		do(&elements[i])
+	}
+}
@@ -0,0 +1,120 @@
+package persistentstack
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+	"github.com/golanglibs/gocollections/comparer"
+	"github.com/golanglibs/gocollections/errors"
+	"github.com/golanglibs/gocollections/generic"
+	"github.com/golanglibs/gocollections/stack"
+	"github.com/golanglibs/gocollections/stack/arraystack"
+	"github.com/golanglibs/gocollections/testhelpers"
+)
+
+func testReadOnlyStacker[T any](s stack.ReadOnlyStacker[T]) {}
+
+func testReadOnlyCollectioner[T any](c generic.ReadOnlyCollectioner[T]) {}
+
+func toSlice[T any](s Stack[T]) []T {
+	elements := make([]T, 0, s.Size())
+	s.ForEach(func(element *T) {
+		elements = append(elements, *element)
+	})
+
+	return elements
+}
+
+func Test_StackShouldImplementReadOnlyStackerAndReadOnlyCollectioner(t *testing.T) {
+	s := New[int]()
+
+	testReadOnlyStacker[int](s)
+	testReadOnlyCollectioner[int](s)
+}
+
+func Test_New(t *testing.T) {
+	s := New(10, 16, 5)
+
+	goassert.Equal(t, 3, s.Size())
+	goassert.False(t, s.Empty())
+	goassert.Equal(t, 5, *s.Peek())
+	goassert.DeepEqual(t, []int{10, 16, 5}, toSlice(s))
+	goassert.True(t, s.Contains(16))
+	goassert.False(t, s.Contains(1))
+}
+
+func Test_NewOfAny(t *testing.T) {
+	s := NewOfAny([]int{1}, []int{2})
+
+	goassert.Equal(t, 2, (*s.Peek())[0])
+	testhelpers.PanicWithErrorIs(
+		t,
+		errors.ErrNoEqualityComparer,
+		"Cannot compute equality of elements since equality comparer is not set",
+		func() {
+			s.Contains([]int{1})
+		},
+	)
+}
+
+func Test_ZeroValueStackShouldBeEmpty(t *testing.T) {
+	var s Stack[int]
+
+	goassert.True(t, s.Empty())
+	goassert.Equal(t, 10, *s.Push(10).Peek())
+	goassert.True(t, s.Empty())
+}
+
+func Test_StackPushAndPopShouldReturnNewStacks_AndLeaveOldStacksUntouched(t *testing.T) {
+	base := New(10, 16)
+
+	pushed := base.Push(5)
+	popped := base.Pop()
+	branch := popped.Push(1)
+
+	goassert.DeepEqual(t, []int{10, 16}, toSlice(base))
+	goassert.DeepEqual(t, []int{10, 16, 5}, toSlice(pushed))
+	goassert.DeepEqual(t, []int{10}, toSlice(popped))
+	goassert.DeepEqual(t, []int{10, 1}, toSlice(branch))
+	goassert.True(t, pushed.top.next == base.top)
+}
+
+func Test_StackPopAndPeekShouldPanic_GivenStackIsEmpty(t *testing.T) {
+	s := New[int]()
+
+	_, popErr := s.TryPop()
+	_, peekErr := s.TryPeek()
+
+	testhelpers.ErrorIs(t, errors.ErrEmpty, popErr)
+	testhelpers.ErrorIs(t, errors.ErrEmpty, peekErr)
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Stack.Pop failed because stack is empty", func() {
+		s.Pop()
+	})
+	testhelpers.PanicWithErrorIs(t, errors.ErrEmpty, "Stack.Peek failed because stack is empty", func() {
+		s.Peek()
+	})
+}
+
+func Test_StackPeekAndForEachShouldReturnReferencesToCopies(t *testing.T) {
+	s := New(10, 16, 5)
+
+	*s.Peek() = 1
+	s.ForEach(func(element *int) {
+		*element = 1
+	})
+
+	goassert.DeepEqual(t, []int{10, 16, 5}, toSlice(s))
+}
+
+func Test_StackEqualsAndHashCode(t *testing.T) {
+	s := New(10, 16, 5)
+	other := arraystack.New(10, 16, 5)
+
+	goassert.True(t, s.Equals(&other))
+	goassert.True(t, s.Equals(New(10, 16, 5)))
+	goassert.True(t, s.Equals(s.Pop().Push(5)))
+	goassert.False(t, s.Equals(s.Pop().Push(1)))
+	goassert.False(t, s.Equals(New(5, 16, 10)))
+	goassert.False(t, s.Equals(s.Pop()))
+	goassert.Equal(t, other.HashCode(comparer.DefaultHash[int]), s.HashCode(comparer.DefaultHash[int]))
+}
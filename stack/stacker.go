@@ -1,32 +1,48 @@
 package stack
 
-type Stacker[T any] interface {
-	/* Sets the equality comparer to the given function */
-	SetEqualityComparer(equals func(*T, *T) bool)
-
+/*
+Read operations of stack-like collections. A ReadOnlyStacker can be passed to callers which must not mutate the
+stack. Note that a Stacker also satisfies ReadOnlyStacker
+*/
+type ReadOnlyStacker[T any] interface {
 	/* Returns the size of the stack */
 	Size() int
 
 	/* Returns true if the stack is empty. Otherwise, false */
 	Empty() bool
 
-	/* Pushes the given value to the stack */
-	Push(element T)
-
-	/* Removes the element to the stack. Panics if the stack is empty */
-	Pop()
-
 	/*
 		Returns a reference to the recently pushed element in the stack without removing it. Panics if stack is
 		empty
 	*/
 	Peek() *T
 
+	/*
+		Returns a reference to the recently pushed element in the stack without removing it. Returns an error
+		wrapping errors.ErrEmpty instead of panicking if the stack is empty
+	*/
+	TryPeek() (*T, error)
+
 	/* Returns true if the given value is found in the stack. Otherwise, false */
 	Contains(element T) bool
 
-	/* Empties the stack. Operations performed depends on the implementation */
-	Clear()
+	/*
+		Returns true if the given value is found in the stack. Returns an error wrapping
+		errors.ErrNoEqualityComparer instead of panicking if the equality comparer is not set
+	*/
+	TryContains(element T) (bool, error)
+
+	/*
+		Returns true if the given stack has the same size and equal elements in the same order from bottom to
+		top. Panics if the equality comparer is not set
+	*/
+	Equals(other ReadOnlyStacker[T]) bool
+
+	/*
+		Returns a hash code which depends on the elements and their order. Elements are hashed with the given
+		function, which must return equal hashes for elements the equality comparer considers equal
+	*/
+	HashCode(hash func(*T) uint64) uint64
 
 	/*
 		Iterates through each element in the stack and executes the given function. Note that the order of
@@ -34,3 +50,37 @@ type Stacker[T any] interface {
 	*/
 	ForEach(do func(*T))
 }
+
+type Stacker[T any] interface {
+	ReadOnlyStacker[T]
+
+	/* Sets the equality comparer to the given function */
+	SetEqualityComparer(equals func(*T, *T) bool)
+
+	/* Pushes the given value to the stack */
+	Push(element T)
+
+	/* Removes the element to the stack. Panics if the stack is empty */
+	Pop()
+
+	/*
+		Removes the recently pushed element from the stack and returns it. Returns an error wrapping
+		errors.ErrEmpty instead of panicking if the stack is empty
+	*/
+	TryPop() (T, error)
+
+	/*
+		Removes the recently pushed element from the stack and returns it along with true. Returns the zero value
+		and false if the stack is empty
+	*/
+	PopValue() (T, bool)
+
+	/*
+		Removes up to n elements from the top of the stack and returns them in the order they were popped.
+		Returns fewer than n elements if the stack runs out of elements
+	*/
+	PopN(n int) []T
+
+	/* Empties the stack. Operations performed depends on the implementation */
+	Clear()
+}
@@ -0,0 +1,17 @@
+package testhelpers
+
+import (
+	"errors"
+	"testing"
+)
+
+/*
+Asserts that the given error wraps the given target error
+*/
+func ErrorIs(t testing.TB, target error, actual error) {
+	t.Helper()
+
+	if !errors.Is(actual, target) {
+		t.Errorf("Expected an error wrapping %v but got %v", target, actual)
+	}
+}
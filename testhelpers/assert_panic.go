@@ -0,0 +1,40 @@
+package testhelpers
+
+import (
+	"errors"
+	"testing"
+)
+
+/*
+Asserts that the given function panics with an error which wraps the given target error and has the given
+message
+*/
+func PanicWithErrorIs(t testing.TB, target error, expectedMessage string, underTest func()) {
+	t.Helper()
+
+	defer func() {
+		t.Helper()
+
+		r := recover()
+		if r == nil {
+			t.Error("Expected panic but there was no panic")
+			return
+		}
+
+		err, isError := r.(error)
+		if !isError {
+			t.Errorf("Expected panic with an error but got %v", r)
+			return
+		}
+
+		if !errors.Is(err, target) {
+			t.Errorf("Expected panic with an error wrapping %v but got %v", target, err)
+		}
+
+		if err.Error() != expectedMessage {
+			t.Errorf("Expected panic with %q error but got %q error", expectedMessage, err.Error())
+		}
+	}()
+
+	underTest()
+}
@@ -0,0 +1,17 @@
+package testhelpers
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+func EncodeInt(w io.Writer, element *int) error {
+	return binary.Write(w, binary.LittleEndian, int64(*element))
+}
+
+func DecodeInt(r io.Reader) (int, error) {
+	var element int64
+	err := binary.Read(r, binary.LittleEndian, &element)
+
+	return int(element), err
+}